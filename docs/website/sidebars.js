@@ -54,6 +54,11 @@ const sidebars = {
       id: 'multitenancy',
       label: 'Multitenancy',
     },
+    {
+      type: 'doc',
+      id: 'testing',
+      label: 'Offline Testing',
+    },
     {
       type: 'doc',
       id: 'options',
@@ -118,7 +118,7 @@ func (c *keyPairsClientImpl) Create(ctx context.Context, projectID string, body
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -133,7 +133,7 @@ func (c *keyPairsClientImpl) Create(ctx context.Context, projectID string, body
 	if response.IsSuccess() {
 		var data types.KeyPairResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
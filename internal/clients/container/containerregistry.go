@@ -118,7 +118,7 @@ func (c *containerRegistryClientImpl) Create(ctx context.Context, projectID stri
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.ContainerRegistryResponse]{
@@ -131,7 +131,7 @@ func (c *containerRegistryClientImpl) Create(ctx context.Context, projectID stri
 	if response.IsSuccess() {
 		var data types.ContainerRegistryResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -181,7 +181,7 @@ func (c *containerRegistryClientImpl) Update(ctx context.Context, projectID stri
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.ContainerRegistryResponse]{
@@ -194,7 +194,7 @@ func (c *containerRegistryClientImpl) Update(ctx context.Context, projectID stri
 	if response.IsSuccess() {
 		var data types.ContainerRegistryResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
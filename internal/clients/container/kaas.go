@@ -120,7 +120,7 @@ func (c *kaasClientImpl) Create(ctx context.Context, projectID string, body type
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -135,7 +135,7 @@ func (c *kaasClientImpl) Create(ctx context.Context, projectID string, body type
 	if response.IsSuccess() {
 		var data types.KaaSResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -187,7 +187,7 @@ func (c *kaasClientImpl) Update(ctx context.Context, projectID string, kaasID st
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -202,7 +202,7 @@ func (c *kaasClientImpl) Update(ctx context.Context, projectID string, kaasID st
 	if response.IsSuccess() {
 		var data types.KaaSResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -119,7 +119,7 @@ func (c *databasesClientImpl) Create(ctx context.Context, projectID string, dbaa
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -134,7 +134,7 @@ func (c *databasesClientImpl) Create(ctx context.Context, projectID string, dbaa
 	if response.IsSuccess() {
 		var data types.DatabaseResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -183,7 +183,7 @@ func (c *databasesClientImpl) Update(ctx context.Context, projectID string, dbaa
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -198,7 +198,7 @@ func (c *databasesClientImpl) Update(ctx context.Context, projectID string, dbaa
 	if response.IsSuccess() {
 		var data types.DatabaseResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
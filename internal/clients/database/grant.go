@@ -119,7 +119,7 @@ func (c *grantsClientImpl) Create(ctx context.Context, projectID string, dbaasID
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -134,7 +134,7 @@ func (c *grantsClientImpl) Create(ctx context.Context, projectID string, dbaasID
 	if response.IsSuccess() {
 		var data types.GrantResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -183,7 +183,7 @@ func (c *grantsClientImpl) Update(ctx context.Context, projectID string, dbaasID
 	// Read the response body
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	// Create the response wrapper
@@ -198,7 +198,7 @@ func (c *grantsClientImpl) Update(ctx context.Context, projectID string, dbaasID
 	if response.IsSuccess() {
 		var data types.GrantResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -122,7 +122,7 @@ func (c *securityGroupRulesClientImpl) Create(ctx context.Context, projectID str
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.SecurityRuleResponse]{
@@ -135,7 +135,7 @@ func (c *securityGroupRulesClientImpl) Create(ctx context.Context, projectID str
 	if response.IsSuccess() {
 		var data types.SecurityRuleResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -185,7 +185,7 @@ func (c *securityGroupRulesClientImpl) Update(ctx context.Context, projectID str
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.SecurityRuleResponse]{
@@ -198,7 +198,7 @@ func (c *securityGroupRulesClientImpl) Update(ctx context.Context, projectID str
 	if response.IsSuccess() {
 		var data types.SecurityRuleResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -122,7 +122,7 @@ func (c *securityGroupsClientImpl) Create(ctx context.Context, projectID string,
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.SecurityGroupResponse]{
@@ -135,7 +135,7 @@ func (c *securityGroupsClientImpl) Create(ctx context.Context, projectID string,
 	if response.IsSuccess() {
 		var data types.SecurityGroupResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -185,7 +185,7 @@ func (c *securityGroupsClientImpl) Update(ctx context.Context, projectID string,
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.SecurityGroupResponse]{
@@ -198,7 +198,7 @@ func (c *securityGroupsClientImpl) Update(ctx context.Context, projectID string,
 	if response.IsSuccess() {
 		var data types.SecurityGroupResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
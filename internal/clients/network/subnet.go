@@ -121,7 +121,7 @@ func (c *subnetsClientImpl) Create(ctx context.Context, projectID string, vpcID
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.SubnetResponse]{
@@ -134,7 +134,7 @@ func (c *subnetsClientImpl) Create(ctx context.Context, projectID string, vpcID
 	if response.IsSuccess() {
 		var data types.SubnetResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -184,7 +184,7 @@ func (c *subnetsClientImpl) Update(ctx context.Context, projectID string, vpcID
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.SubnetResponse]{
@@ -197,7 +197,7 @@ func (c *subnetsClientImpl) Update(ctx context.Context, projectID string, vpcID
 	if response.IsSuccess() {
 		var data types.SubnetResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -117,7 +117,7 @@ func (c *vpcsClientImpl) Create(ctx context.Context, projectID string, body type
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.VPCResponse]{
@@ -130,7 +130,7 @@ func (c *vpcsClientImpl) Create(ctx context.Context, projectID string, body type
 	if response.IsSuccess() {
 		var data types.VPCResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -180,7 +180,7 @@ func (c *vpcsClientImpl) Update(ctx context.Context, projectID string, vpcID str
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.VPCResponse]{
@@ -193,7 +193,7 @@ func (c *vpcsClientImpl) Update(ctx context.Context, projectID string, vpcID str
 	if response.IsSuccess() {
 		var data types.VPCResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
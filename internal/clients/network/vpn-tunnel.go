@@ -117,7 +117,7 @@ func (c *vpnTunnelsClientImpl) Create(ctx context.Context, projectID string, bod
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.VPNTunnelResponse]{
@@ -130,7 +130,7 @@ func (c *vpnTunnelsClientImpl) Create(ctx context.Context, projectID string, bod
 	if response.IsSuccess() {
 		var data types.VPNTunnelResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -180,7 +180,7 @@ func (c *vpnTunnelsClientImpl) Update(ctx context.Context, projectID string, vpn
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.VPNTunnelResponse]{
@@ -193,7 +193,7 @@ func (c *vpnTunnelsClientImpl) Update(ctx context.Context, projectID string, vpn
 	if response.IsSuccess() {
 		var data types.VPNTunnelResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
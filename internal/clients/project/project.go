@@ -110,7 +110,7 @@ func (c *projectsClientImpl) Create(ctx context.Context, body types.ProjectReque
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.ProjectResponse]{
@@ -123,7 +123,7 @@ func (c *projectsClientImpl) Create(ctx context.Context, body types.ProjectReque
 	if response.IsSuccess() {
 		var data types.ProjectResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -173,7 +173,7 @@ func (c *projectsClientImpl) Update(ctx context.Context, projectID string, body
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.ProjectResponse]{
@@ -186,7 +186,7 @@ func (c *projectsClientImpl) Update(ctx context.Context, projectID string, body
 	if response.IsSuccess() {
 		var data types.ProjectResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
@@ -228,7 +228,7 @@ func (c *projectsClientImpl) Delete(ctx context.Context, projectID string, param
 
 	bodyBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[any]{
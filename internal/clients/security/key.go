@@ -122,7 +122,7 @@ func (c *KeyClientImpl) Create(ctx context.Context, projectID string, kmsID stri
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.KeyResponse]{
@@ -135,7 +135,7 @@ func (c *KeyClientImpl) Create(ctx context.Context, projectID string, kmsID stri
 	if response.IsSuccess() {
 		var data types.KeyResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
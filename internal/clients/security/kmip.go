@@ -122,7 +122,7 @@ func (c *KmipClientImpl) Create(ctx context.Context, projectID string, kmsID str
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.KmipResponse]{
@@ -135,7 +135,7 @@ func (c *KmipClientImpl) Create(ctx context.Context, projectID string, kmsID str
 	if response.IsSuccess() {
 		var data types.KmipResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
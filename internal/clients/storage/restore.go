@@ -129,7 +129,7 @@ func (c *restoreClientImpl) Create(ctx context.Context, projectID string, backup
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.StorageRestoreResponse]{
@@ -142,7 +142,7 @@ func (c *restoreClientImpl) Create(ctx context.Context, projectID string, backup
 	if response.IsSuccess() {
 		var data types.StorageRestoreResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 		if err := response.Data.Metadata.Validate(); err != nil {
@@ -194,7 +194,7 @@ func (c *restoreClientImpl) Update(ctx context.Context, projectID string, backup
 
 	respBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("%w: %w", types.ErrReadResponse, err)
 	}
 
 	response := &types.Response[types.StorageRestoreResponse]{
@@ -207,7 +207,7 @@ func (c *restoreClientImpl) Update(ctx context.Context, projectID string, backup
 	if response.IsSuccess() {
 		var data types.StorageRestoreResponse
 		if err := json.Unmarshal(respBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, fmt.Errorf("%w: %w", types.ErrParseResponse, err)
 		}
 		response.Data = &data
 	} else if response.IsError() && len(respBytes) > 0 {
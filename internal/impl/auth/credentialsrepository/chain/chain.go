@@ -0,0 +1,56 @@
+// Package chain provides an implementation of the auth.CredentialsRepository
+// trying several repositories in turn.
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// CredentialsRepository is a struct that implements the
+// auth.CredentialsRepository interface returning the credentials of the first
+// repository of the chain holding some.
+type CredentialsRepository struct {
+	repositories []auth.CredentialsRepository
+}
+
+var _ auth.CredentialsRepository = (*CredentialsRepository)(nil)
+
+// NewCredentialsChain is the constructor for CredentialsRepository. The
+// repositories are tried in the given order.
+func NewCredentialsChain(repositories ...auth.CredentialsRepository) *CredentialsRepository {
+	return &CredentialsRepository{
+		repositories: repositories,
+	}
+}
+
+// FetchCredentials returns the credentials of the first repository resolving
+// them. Repositories returning auth.ErrCredentialsNotFound are skipped; any
+// other error stops the chain, so that a broken source is not silently
+// replaced by the next one. auth.ErrCredentialsNotFound is returned, along
+// with the reasons of each repository, when none resolves.
+func (r *CredentialsRepository) FetchCredentials(ctx context.Context) (*auth.Credentials, error) {
+	var errs []error
+
+	for _, repository := range r.repositories {
+		credentials, err := repository.FetchCredentials(ctx)
+		if err == nil {
+			return credentials, nil
+		}
+
+		if !errors.Is(err, auth.ErrCredentialsNotFound) {
+			return nil, err
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("%w: empty credentials chain", auth.ErrCredentialsNotFound)
+	}
+
+	return nil, fmt.Errorf("%w: no source resolved: %w", auth.ErrCredentialsNotFound, errors.Join(errs...))
+}
@@ -0,0 +1,79 @@
+package chain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+//go:generate mockgen -package chain -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth CredentialsRepository
+
+func TestCredentialsChain_FetchCredentials(t *testing.T) {
+	credentials := &auth.Credentials{ClientID: "client id", ClientSecret: "client secret"}
+
+	t.Run("should return the credentials of the first repository resolving them", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a chain whose first repository has no credentials
+		first := NewMockCredentialsRepository(ctrl)
+		first.EXPECT().FetchCredentials(gomock.Any()).Return(nil, auth.ErrCredentialsNotFound).Times(1)
+
+		second := NewMockCredentialsRepository(ctrl)
+		second.EXPECT().FetchCredentials(gomock.Any()).Return(credentials, nil).Times(1)
+
+		// And a last repository which should not be reached
+		last := NewMockCredentialsRepository(ctrl)
+
+		chain := NewCredentialsChain(first, second, last)
+
+		// When we fetch the credentials
+		got, err := chain.FetchCredentials(t.Context())
+
+		// Then the ones of the second repository are returned
+		require.NoError(t, err)
+		require.Same(t, credentials, got)
+	})
+
+	t.Run("should stop on an unexpected error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a chain whose first repository is broken
+		errBroken := errors.New("permission denied")
+		first := NewMockCredentialsRepository(ctrl)
+		first.EXPECT().FetchCredentials(gomock.Any()).Return(nil, errBroken).Times(1)
+
+		chain := NewCredentialsChain(first, NewMockCredentialsRepository(ctrl))
+
+		// When we fetch the credentials
+		_, err := chain.FetchCredentials(t.Context())
+
+		// Then the error is returned without trying the next repository
+		require.ErrorIs(t, err, errBroken)
+	})
+
+	t.Run("should report credentials not found when no repository resolves", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a chain of repositories without credentials
+		first := NewMockCredentialsRepository(ctrl)
+		first.EXPECT().FetchCredentials(gomock.Any()).Return(nil, errors.Join(auth.ErrCredentialsNotFound, errors.New("no variables"))).Times(1)
+
+		second := NewMockCredentialsRepository(ctrl)
+		second.EXPECT().FetchCredentials(gomock.Any()).Return(nil, errors.Join(auth.ErrCredentialsNotFound, errors.New("no profile"))).Times(1)
+
+		// When we fetch the credentials
+		_, err := NewCredentialsChain(first, second).FetchCredentials(t.Context())
+
+		// Then all the reasons are reported
+		require.ErrorIs(t, err, auth.ErrCredentialsNotFound)
+		require.ErrorContains(t, err, "no variables")
+		require.ErrorContains(t, err, "no profile")
+	})
+}
@@ -0,0 +1,54 @@
+// Package env provides an implementation of the auth.CredentialsRepository
+// reading the client credentials from environment variables.
+package env
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+const (
+	// ClientIDVariable is the environment variable holding the client ID.
+	ClientIDVariable = "ARUBA_CLIENT_ID"
+
+	// ClientSecretVariable is the environment variable holding the client
+	// secret.
+	ClientSecretVariable = "ARUBA_CLIENT_SECRET"
+)
+
+// CredentialsRepository is a struct that implements the
+// auth.CredentialsRepository interface using the ClientIDVariable and
+// ClientSecretVariable environment variables.
+type CredentialsRepository struct{}
+
+var _ auth.CredentialsRepository = (*CredentialsRepository)(nil)
+
+// NewCredentialsRepository is the constructor for CredentialsRepository.
+func NewCredentialsRepository() *CredentialsRepository {
+	return &CredentialsRepository{}
+}
+
+// FetchCredentials reads the credentials from the environment. It returns
+// auth.ErrCredentialsNotFound when neither variable is set, and an error when
+// only one of them is, as that is likely a mistake.
+func (r *CredentialsRepository) FetchCredentials(ctx context.Context) (*auth.Credentials, error) {
+	clientID := os.Getenv(ClientIDVariable)
+	clientSecret := os.Getenv(ClientSecretVariable)
+
+	switch {
+	case clientID == "" && clientSecret == "":
+		return nil, fmt.Errorf("%w: %s and %s are not set", auth.ErrCredentialsNotFound, ClientIDVariable, ClientSecretVariable)
+	case clientID == "":
+		return nil, fmt.Errorf("incomplete credentials in the environment: %s is not set", ClientIDVariable)
+	case clientSecret == "":
+		return nil, fmt.Errorf("incomplete credentials in the environment: %s is not set", ClientSecretVariable)
+	}
+
+	return &auth.Credentials{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+func TestCredentialsRepository_FetchCredentials(t *testing.T) {
+	t.Run("should return the credentials of the environment", func(t *testing.T) {
+		// Given both variables are set
+		t.Setenv(ClientIDVariable, "client id")
+		t.Setenv(ClientSecretVariable, "client secret")
+
+		// When we fetch the credentials
+		credentials, err := NewCredentialsRepository().FetchCredentials(t.Context())
+
+		// Then they are returned
+		require.NoError(t, err)
+		require.Equal(t, &auth.Credentials{ClientID: "client id", ClientSecret: "client secret"}, credentials)
+	})
+
+	t.Run("should report credentials not found when no variable is set", func(t *testing.T) {
+		t.Setenv(ClientIDVariable, "")
+		t.Setenv(ClientSecretVariable, "")
+
+		_, err := NewCredentialsRepository().FetchCredentials(t.Context())
+		require.ErrorIs(t, err, auth.ErrCredentialsNotFound)
+	})
+
+	t.Run("should report incomplete credentials", func(t *testing.T) {
+		t.Setenv(ClientIDVariable, "client id")
+		t.Setenv(ClientSecretVariable, "")
+
+		_, err := NewCredentialsRepository().FetchCredentials(t.Context())
+		require.ErrorContains(t, err, ClientSecretVariable+" is not set")
+		require.NotErrorIs(t, err, auth.ErrCredentialsNotFound)
+	})
+}
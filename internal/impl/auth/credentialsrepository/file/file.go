@@ -0,0 +1,54 @@
+// Package file provides an implementation of the auth.CredentialsRepository
+// reading the private key signing the client assertions from the local file
+// system.
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// CredentialsRepository is a struct that implements the
+// auth.CredentialsRepository interface for clients authenticating with a
+// private key stored in a PEM file.
+// The file is read on every fetch: the key is rotated by replacing it.
+type CredentialsRepository struct {
+	clientID string // The OAuth2 client ID.
+	keyPath  string // The path to the PEM-encoded private key.
+	keyID    string // The optional ID of the key, known by the IdP.
+}
+
+var _ auth.CredentialsRepository = (*CredentialsRepository)(nil)
+
+// NewCredentialsRepository is the constructor for CredentialsRepository.
+func NewCredentialsRepository(clientID, keyPath, keyID string) *CredentialsRepository {
+	return &CredentialsRepository{
+		clientID: clientID,
+		keyPath:  keyPath,
+		keyID:    keyID,
+	}
+}
+
+// FetchCredentials reads the private key file and returns it along with the
+// client and key IDs.
+func (r *CredentialsRepository) FetchCredentials(ctx context.Context) (*auth.Credentials, error) {
+	data, err := os.ReadFile(r.keyPath)
+	if err != nil {
+		// Check if the error is due to the file not existing.
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", auth.ErrCredentialsNotFound, err)
+		}
+		// Wrap other file access errors (permission denied, etc.)
+		return nil, fmt.Errorf("failed to read private key file: %w", err)
+	}
+
+	return &auth.Credentials{
+		ClientID:   r.clientID,
+		PrivateKey: string(data),
+		KeyID:      r.keyID,
+	}, nil
+}
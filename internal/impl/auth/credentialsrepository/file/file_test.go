@@ -0,0 +1,49 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+const (
+	clientID = "client id"
+	keyID    = "key-1"
+)
+
+func TestCredentialsRepository_FetchCredentials(t *testing.T) {
+	t.Run("should return the key of the file", func(t *testing.T) {
+		// Given a private key file
+		path := filepath.Join(t.TempDir(), "client.pem")
+		require.NoError(t, os.WriteFile(path, []byte("first key"), 0o600))
+
+		repo := NewCredentialsRepository(clientID, path, keyID)
+
+		// When we fetch the credentials
+		credentials, err := repo.FetchCredentials(t.Context())
+
+		// Then the key is returned along with the client and key IDs
+		require.NoError(t, err)
+		require.Equal(t, &auth.Credentials{ClientID: clientID, PrivateKey: "first key", KeyID: keyID}, credentials)
+
+		// When the key is rotated
+		require.NoError(t, os.WriteFile(path, []byte("second key"), 0o600))
+		credentials, err = repo.FetchCredentials(t.Context())
+
+		// Then the new key is returned
+		require.NoError(t, err)
+		require.Equal(t, "second key", credentials.PrivateKey)
+	})
+
+	t.Run("should report credentials not found when no key file", func(t *testing.T) {
+		repo := NewCredentialsRepository(clientID, filepath.Join(t.TempDir(), "client.pem"), "")
+
+		credentials, err := repo.FetchCredentials(t.Context())
+		require.ErrorIs(t, err, auth.ErrCredentialsNotFound)
+		require.Nil(t, credentials)
+	})
+}
@@ -5,6 +5,7 @@ package memory
 
 import (
 	"context"
+	"crypto"
 	"sync"
 
 	"github.com/Arubacloud/sdk-go/internal/ports/auth"
@@ -40,6 +41,19 @@ func NewCredentialsRepository(clientID string, clientSecret string) *Credentials
 	}
 }
 
+// NewCredentialsRepositoryWithSigner creates a repository with static
+// credentials authenticating the client with assertions signed by signer,
+// identified to the IdP by the optional keyID.
+func NewCredentialsRepositoryWithSigner(clientID string, signer crypto.Signer, keyID string) *CredentialsRepository {
+	return &CredentialsRepository{
+		credentials: &auth.Credentials{
+			ClientID: clientID,
+			Signer:   signer,
+			KeyID:    keyID,
+		},
+	}
+}
+
 // NewCredentialsProxy creates a repository that acts as a caching layer.
 // It does not hold credentials initially; it fetches them from the persistentRepository
 // only when FetchCredentials is first called (Lazy Loading).
@@ -1,6 +1,9 @@
 package memory
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"testing"
 
@@ -38,6 +41,24 @@ func TestCredentialsRepository_FetchCredentials(t *testing.T) {
 		// And the credential holders should not be the same
 		require.NotSame(t, credentialsRepository.credentials, credentials)
 	})
+
+	t.Run("should return the signer", func(t *testing.T) {
+		// Given a credentials repository holding a signer
+		signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		credentialsRepository := NewCredentialsRepositoryWithSigner(clientID, signer, "key-1")
+
+		// When we try to fetch the credentials
+		credentials, err := credentialsRepository.FetchCredentials(t.Context())
+
+		// Then the signer is returned with the client and key IDs
+		require.NoError(t, err)
+		require.Equal(t, clientID, credentials.ClientID)
+		require.Same(t, signer, credentials.Signer)
+		require.Equal(t, "key-1", credentials.KeyID)
+		require.Empty(t, credentials.ClientSecret)
+	})
 }
 
 func TestCredentialsProxy_FetchCredentials(t *testing.T) {
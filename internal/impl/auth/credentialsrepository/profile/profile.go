@@ -0,0 +1,57 @@
+// Package profile provides an implementation of the auth.CredentialsRepository
+// reading the client credentials of a profile of the profiles file.
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+	"github.com/Arubacloud/sdk-go/internal/profile"
+)
+
+// CredentialsRepository is a struct that implements the
+// auth.CredentialsRepository interface using a profile of the profiles file.
+// The file is read on every fetch.
+type CredentialsRepository struct {
+	path string // The path to the profiles file.
+	name string // The name of the profile.
+}
+
+var _ auth.CredentialsRepository = (*CredentialsRepository)(nil)
+
+// NewCredentialsRepository is the constructor for CredentialsRepository.
+func NewCredentialsRepository(path, name string) *CredentialsRepository {
+	return &CredentialsRepository{
+		path: path,
+		name: name,
+	}
+}
+
+// FetchCredentials reads the credentials of the profile. It returns
+// auth.ErrCredentialsNotFound when the file, the profile or its client ID is
+// missing.
+func (r *CredentialsRepository) FetchCredentials(ctx context.Context) (*auth.Credentials, error) {
+	p, err := profile.Load(r.path, r.name)
+	if err != nil {
+		if errors.Is(err, profile.ErrFileNotFound) || errors.Is(err, profile.ErrProfileNotFound) {
+			return nil, fmt.Errorf("%w: %w", auth.ErrCredentialsNotFound, err)
+		}
+		return nil, err
+	}
+
+	switch {
+	case p.ClientID == "" && p.ClientSecret == "":
+		return nil, fmt.Errorf("%w: no client ID in profile %q", auth.ErrCredentialsNotFound, r.name)
+	case p.ClientID == "":
+		return nil, fmt.Errorf("incomplete credentials in profile %q: client_id is not set", r.name)
+	case p.ClientSecret == "":
+		return nil, fmt.Errorf("incomplete credentials in profile %q: client_secret is not set", r.name)
+	}
+
+	return &auth.Credentials{
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+const profiles = `
+[default]
+client_id = client id
+client_secret = client secret
+
+[urls-only]
+base_url = https://api.example.com
+
+[incomplete]
+client_id = client id
+`
+
+func TestCredentialsRepository_FetchCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(profiles), 0o600))
+
+	t.Run("should return the credentials of the profile", func(t *testing.T) {
+		// When we fetch the credentials of a complete profile
+		credentials, err := NewCredentialsRepository(path, "default").FetchCredentials(t.Context())
+
+		// Then they are returned
+		require.NoError(t, err)
+		require.Equal(t, &auth.Credentials{ClientID: "client id", ClientSecret: "client secret"}, credentials)
+	})
+
+	t.Run("should report credentials not found", func(t *testing.T) {
+		for _, repository := range []*CredentialsRepository{
+			NewCredentialsRepository(filepath.Join(t.TempDir(), "config"), "default"),
+			NewCredentialsRepository(path, "unknown"),
+			NewCredentialsRepository(path, "urls-only"),
+		} {
+			_, err := repository.FetchCredentials(t.Context())
+			require.ErrorIs(t, err, auth.ErrCredentialsNotFound)
+		}
+	})
+
+	t.Run("should report incomplete credentials", func(t *testing.T) {
+		_, err := NewCredentialsRepository(path, "incomplete").FetchCredentials(t.Context())
+		require.ErrorContains(t, err, "client_secret is not set")
+		require.NotErrorIs(t, err, auth.ErrCredentialsNotFound)
+	})
+}
@@ -0,0 +1,252 @@
+package oauth2
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// ClientAssertionType is the client_assertion_type of JWT client assertions
+// (RFC 7523).
+const ClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// ErrUnsupportedKey reports a private key which cannot sign client
+// assertions: only RSA and ECDSA (P-256, P-384 and P-521) keys are supported.
+var ErrUnsupportedKey = errors.New("unsupported private key")
+
+// clientAssertionLifetime is the validity of a client assertion. It is only
+// used once, right after being signed.
+const clientAssertionLifetime = time.Minute
+
+// ClientAssertionConnector implements auth.ProviderConnector.
+// It uses the OAuth2 Client Credentials grant type, authenticating the client
+// with a JWT signed by its private key (the private_key_jwt method of OpenID
+// Connect, RFC 7523) instead of a client secret.
+type ClientAssertionConnector struct {
+	credentialsRepository auth.CredentialsRepository
+	tokenURL              string
+	scopes                []string
+	options
+}
+
+var _ auth.ProviderConnector = (*ClientAssertionConnector)(nil)
+
+// NewClientAssertionConnector creates a new connector instance.
+// The credentials of credentialsRepository must hold a Signer or a PEM-encoded
+// PrivateKey; they are fetched for every token, so that the key can be rotated
+// by the repository.
+// tokenURL is the token endpoint of the IdP, and the audience of the
+// assertions. scopes are the permissions requested for the token.
+func NewClientAssertionConnector(credentialsRepository auth.CredentialsRepository, tokenURL string, scopes []string, opts ...Option) *ClientAssertionConnector {
+	return &ClientAssertionConnector{
+		credentialsRepository: credentialsRepository,
+		tokenURL:              tokenURL,
+		scopes:                scopes,
+		options:               newOptions(opts),
+	}
+}
+
+// RequestToken retrieves the credentials from the repository, signs a client
+// assertion with their key and exchanges it for a new OAuth2 token.
+func (c *ClientAssertionConnector) RequestToken(ctx context.Context) (*auth.Token, error) {
+	credentials, err := c.credentialsRepository.FetchCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch credentials for oauth2 exchange: %w", err)
+	}
+
+	signer := credentials.Signer
+	if signer == nil {
+		if credentials.PrivateKey == "" {
+			return nil, fmt.Errorf("%w: no private key", auth.ErrCredentialsNotFound)
+		}
+
+		signer, err = parsePrivateKey([]byte(credentials.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	assertion, err := signClientAssertion(signer, credentials.KeyID, credentials.ClientID, c.tokenURL, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	oauth2Config := clientcredentials.Config{
+		ClientID: credentials.ClientID,
+		TokenURL: c.tokenURL,
+		Scopes:   c.scopes,
+		EndpointParams: url.Values{
+			"client_assertion_type": {ClientAssertionType},
+			"client_assertion":      {assertion},
+		},
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+
+	oauth2Token, err := oauth2Config.Token(c.withHTTPClient(ctx))
+	if err != nil {
+		return nil, wrapOAuth2Error(err)
+	}
+
+	return &auth.Token{
+		AccessToken: oauth2Token.AccessToken,
+		Expiry:      oauth2Token.Expiry,
+	}, nil
+}
+
+// parsePrivateKey parses a PEM-encoded RSA or ECDSA private key, in the PKCS
+// #8, PKCS #1 or SEC 1 format.
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrUnsupportedKey)
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedKey, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+	if _, _, err := signingAlgorithm(signer); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// signingAlgorithm returns the JWS algorithm (RFC 7518) of the key of signer
+// and its hash function.
+func signingAlgorithm(signer crypto.Signer) (string, crypto.Hash, error) {
+	switch key := signer.Public().(type) {
+	case *rsa.PublicKey:
+		return "RS256", crypto.SHA256, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return "ES256", crypto.SHA256, nil
+		case elliptic.P384():
+			return "ES384", crypto.SHA384, nil
+		case elliptic.P521():
+			return "ES512", crypto.SHA512, nil
+		}
+		return "", 0, fmt.Errorf("%w: ECDSA curve %s", ErrUnsupportedKey, key.Curve.Params().Name)
+	}
+
+	return "", 0, fmt.Errorf("%w: %T", ErrUnsupportedKey, signer.Public())
+}
+
+// signClientAssertion returns a JWT asserting the identity of the client
+// clientID to the token endpoint audience, signed by signer.
+func signClientAssertion(signer crypto.Signer, keyID, clientID, audience string, now time.Time) (string, error) {
+	algorithm, hash, err := signingAlgorithm(signer)
+	if err != nil {
+		return "", err
+	}
+
+	header := map[string]string{
+		"alg": algorithm,
+		"typ": "JWT",
+	}
+	if keyID != "" {
+		header["kid"] = keyID
+	}
+
+	claims := map[string]any{
+		"iss": clientID,
+		"sub": clientID,
+		"aud": audience,
+		"jti": rand.Text(),
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+	}
+
+	encodedHeader, err := encodeSegment(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := encodedHeader + "." + encodedClaims
+
+	digest := hash.New()
+	digest.Write([]byte(signingInput))
+
+	signature, err := signer.Sign(rand.Reader, digest.Sum(nil), hash)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign the client assertion: %w", err)
+	}
+
+	// ECDSA signers return an ASN.1 signature, while JWS expects the
+	// concatenation of R and S (RFC 7518, section 3.4).
+	if key, ok := signer.Public().(*ecdsa.PublicKey); ok {
+		signature, err = concatECDSASignature(signature, (key.Curve.Params().BitSize+7)/8)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func encodeSegment(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// concatECDSASignature converts an ASN.1 ECDSA signature into R and S
+// left-padded to size bytes each.
+func concatECDSASignature(signature []byte, size int) ([]byte, error) {
+	var rs struct {
+		R, S *big.Int
+	}
+	if rest, err := asn1.Unmarshal(signature, &rs); err != nil || len(rest) > 0 ||
+		rs.R.Sign() < 0 || rs.S.Sign() < 0 || rs.R.BitLen() > 8*size || rs.S.BitLen() > 8*size {
+		return nil, errors.New("failed to sign the client assertion: malformed ECDSA signature")
+	}
+
+	concatenated := make([]byte, 2*size)
+	rs.R.FillBytes(concatenated[:size])
+	rs.S.FillBytes(concatenated[size:])
+
+	return concatenated, nil
+}
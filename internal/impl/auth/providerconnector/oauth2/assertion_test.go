@@ -0,0 +1,299 @@
+package oauth2
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// assertionServer is a token endpoint authenticating clients with JWT client
+// assertions signed by the registered keys.
+type assertionServer struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	keys   map[string]crypto.PublicKey // by key ID
+	claims []map[string]any
+}
+
+func setupAssertionServer(t *testing.T, keys map[string]crypto.PublicKey) *assertionServer {
+	t.Helper()
+
+	server := &assertionServer{keys: keys}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Empty(t, r.PostForm.Get("client_secret"))
+
+		claims, ok := server.verify(t, r.PostForm.Get("client_assertion_type"), r.PostForm.Get("client_assertion"))
+		if !ok {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   expireIn,
+		})
+
+		server.mu.Lock()
+		server.claims = append(server.claims, claims)
+		server.mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (s *assertionServer) verify(t *testing.T, assertionType, assertion string) (map[string]any, bool) {
+	t.Helper()
+
+	if assertionType != ClientAssertionType {
+		return nil, false
+	}
+
+	segments := strings.Split(assertion, ".")
+	require.Len(t, segments, 3)
+
+	var header map[string]string
+	decodeSegment(t, segments[0], &header)
+
+	var claims map[string]any
+	decodeSegment(t, segments[1], &claims)
+
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+	require.NoError(t, err)
+
+	s.mu.Lock()
+	key, found := s.keys[header["kid"]]
+	s.mu.Unlock()
+	if !found {
+		return nil, false
+	}
+
+	signingInput := []byte(segments[0] + "." + segments[1])
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		require.Equal(t, "RS256", header["alg"])
+		digest := sha256.Sum256(signingInput)
+		return claims, rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	case *ecdsa.PublicKey:
+		require.Equal(t, "ES384", header["alg"])
+		require.Len(t, signature, 96)
+		digest := sha512.Sum384(signingInput)
+		r, s := new(big.Int).SetBytes(signature[:48]), new(big.Int).SetBytes(signature[48:])
+		return claims, ecdsa.Verify(key, digest[:], r, s)
+	}
+
+	return nil, false
+}
+
+func decodeSegment(t *testing.T, segment string, value any) {
+	t.Helper()
+
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, value))
+}
+
+func encodePEM(t *testing.T, blockType string, der []byte) string {
+	t.Helper()
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
+
+func TestClientAssertionConnector_RequestToken(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("should return a token for an assertion signed by a PEM key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a token endpoint knowing the RSA key of the client
+		server := setupAssertionServer(t, map[string]crypto.PublicKey{"rsa-1": &rsaKey.PublicKey})
+
+		// And a credentials repository holding that key as PEM
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+		credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{
+			ClientID:   clientID,
+			PrivateKey: encodePEM(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+			KeyID:      "rsa-1",
+		}, nil).Times(1)
+
+		connector := NewClientAssertionConnector(credentialsRepository, server.URL, scopes)
+
+		// When we request a token
+		token, err := connector.RequestToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+		require.Equal(t, accessToken, token.AccessToken)
+
+		// And the assertion identifies the client to the token endpoint
+		require.Len(t, server.claims, 1)
+		claims := server.claims[0]
+		require.Equal(t, clientID, claims["iss"])
+		require.Equal(t, clientID, claims["sub"])
+		require.Equal(t, server.URL, claims["aud"])
+		require.NotEmpty(t, claims["jti"])
+		require.InDelta(t, 60, claims["exp"].(float64)-claims["iat"].(float64), 0)
+	})
+
+	t.Run("should use the rotated key on the next token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a token endpoint knowing both the RSA and ECDSA keys
+		server := setupAssertionServer(t, map[string]crypto.PublicKey{
+			"rsa-1":   &rsaKey.PublicKey,
+			"ecdsa-2": &ecdsaKey.PublicKey,
+		})
+
+		// And a credentials repository rotating from the RSA key to the
+		// ECDSA signer
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+		gomock.InOrder(
+			credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{
+				ClientID: clientID,
+				Signer:   rsaKey,
+				KeyID:    "rsa-1",
+			}, nil),
+			credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{
+				ClientID: clientID,
+				Signer:   ecdsaKey,
+				KeyID:    "ecdsa-2",
+			}, nil),
+		)
+
+		connector := NewClientAssertionConnector(credentialsRepository, server.URL, scopes)
+
+		// When we request a token before and after the rotation
+		_, err := connector.RequestToken(t.Context())
+		require.NoError(t, err)
+
+		// And the old key is revoked
+		server.mu.Lock()
+		delete(server.keys, "rsa-1")
+		server.mu.Unlock()
+
+		_, err = connector.RequestToken(t.Context())
+
+		// Then both are accepted
+		require.NoError(t, err)
+		require.Len(t, server.claims, 2)
+	})
+
+	t.Run("should report an authentication failure for an unknown key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a token endpoint knowing no key
+		server := setupAssertionServer(t, map[string]crypto.PublicKey{})
+
+		// And a credentials repository holding a key
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+		credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{
+			ClientID: clientID,
+			Signer:   ecdsaKey,
+		}, nil).Times(1)
+
+		connector := NewClientAssertionConnector(credentialsRepository, server.URL, scopes)
+
+		// When we request a token
+		_, err := connector.RequestToken(t.Context())
+
+		// Then an authentication failure is reported
+		require.ErrorIs(t, err, auth.ErrAuthenticationFailed)
+	})
+
+	t.Run("should report credentials without key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a credentials repository holding a client secret only
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+		credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		}, nil).Times(1)
+
+		connector := NewClientAssertionConnector(credentialsRepository, "http://localhost/token", scopes)
+
+		// When we request a token
+		_, err := connector.RequestToken(t.Context())
+
+		// Then the missing key is reported
+		require.ErrorIs(t, err, auth.ErrCredentialsNotFound)
+	})
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	sec1, err := x509.MarshalECPrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(ecdsaKey)
+	require.NoError(t, err)
+
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519PKCS8, err := x509.MarshalPKCS8PrivateKey(ed25519Key)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pem     string
+		wantErr bool
+	}{
+		{"PKCS #1 RSA key", encodePEM(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)), false},
+		{"SEC 1 ECDSA key", encodePEM(t, "EC PRIVATE KEY", sec1), false},
+		{"PKCS #8 ECDSA key", encodePEM(t, "PRIVATE KEY", pkcs8), false},
+		{"Ed25519 key", encodePEM(t, "PRIVATE KEY", ed25519PKCS8), true},
+		{"certificate", encodePEM(t, "CERTIFICATE", []byte("certificate")), true},
+		{"malformed key", encodePEM(t, "EC PRIVATE KEY", []byte("key")), true},
+		{"no PEM", "key", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When we parse the key
+			signer, err := parsePrivateKey([]byte(tt.pem))
+
+			// Then only RSA and ECDSA keys are accepted
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrUnsupportedKey)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, signer)
+		})
+	}
+}
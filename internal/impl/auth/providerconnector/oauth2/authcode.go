@@ -0,0 +1,154 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// ErrAuthorizationDenied reports that the user, or the IdP, refused the
+// authorization request. It is wrapped along with auth.ErrAuthenticationFailed.
+var ErrAuthorizationDenied = errors.New("authorization denied")
+
+// CallbackPath is the path of the redirect URI served by the loopback
+// listener of the AuthCodeConnector.
+const CallbackPath = "/callback"
+
+// OpenURLFunc opens the authorization page for the user, usually in a
+// browser.
+type OpenURLFunc func(ctx context.Context, url string) error
+
+// AuthCodeConnector implements auth.ProviderConnector.
+// It uses the OAuth2 Authorization Code Grant with PKCE, for interactive tools
+// running where a browser is available: the authorization code is received
+// by a listener bound to the loopback interface (RFC 8252). Refresh tokens
+// are used to renew the token without involving the user again.
+type AuthCodeConnector struct {
+	interactiveGrant
+
+	listenAddress string
+	openURL       OpenURLFunc
+}
+
+var _ auth.ProviderConnector = (*AuthCodeConnector)(nil)
+
+// NewAuthCodeConnector creates a new connector instance for the public
+// client clientID. authURL and tokenURL are the authorization and token
+// endpoints of the IdP. The redirect URI is served on listenAddress (e.g.
+// "127.0.0.1:8250"; port 0 picks a free port) at CallbackPath. openURL is
+// called with the authorization page of every new authorization; a nil
+// openURL prints it on the standard error. repository, which may be nil, is
+// read for a refresh token saved by a previous run.
+func NewAuthCodeConnector(clientID, authURL, tokenURL string, scopes []string, listenAddress string, openURL OpenURLFunc, repository auth.TokenRepository, opts ...Option) *AuthCodeConnector {
+	if openURL == nil {
+		openURL = printAuthorizationURL
+	}
+
+	return &AuthCodeConnector{
+		interactiveGrant: interactiveGrant{
+			config: newPublicClientConfig(clientID, oauth2.Endpoint{
+				AuthURL:  authURL,
+				TokenURL: tokenURL,
+			}, scopes),
+			options:    newOptions(opts),
+			repository: repository,
+		},
+		listenAddress: listenAddress,
+		openURL:       openURL,
+	}
+}
+
+// RequestToken refreshes the token if possible, and asks the user to
+// authorize the client otherwise. It returns once the authorization code has
+// been received and exchanged, or ctx is done.
+func (c *AuthCodeConnector) RequestToken(ctx context.Context) (*auth.Token, error) {
+	return c.requestToken(ctx, c.authorize)
+}
+
+// callbackResult is the outcome of the authorization, as received by the
+// redirect URI.
+type callbackResult struct {
+	code string
+	err  error
+}
+
+func (c *AuthCodeConnector) authorize(ctx context.Context) (*oauth2.Token, error) {
+	listener, err := net.Listen("tcp", c.listenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the authorization code: %w", err)
+	}
+
+	config := c.config
+	config.RedirectURL = "http://" + listener.Addr().String() + CallbackPath
+
+	state := rand.Text()
+	verifier := oauth2.GenerateVerifier()
+
+	results := make(chan callbackResult, 1)
+	server := &http.Server{
+		Handler:           callbackHandler(state, results),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	if err := c.openURL(ctx, config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.err != nil {
+			return nil, result.err
+		}
+		return config.Exchange(ctx, result.code, oauth2.VerifierOption(verifier))
+	}
+}
+
+// callbackHandler serves the redirect URI, passing the outcome of the
+// authorization to results. Requests not carrying the expected state are
+// rejected without ending the authorization.
+func callbackHandler(state string, results chan<- callbackResult) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+CallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("state") != state {
+			http.Error(w, "Invalid state.", http.StatusBadRequest)
+			return
+		}
+
+		var result callbackResult
+		switch {
+		case query.Has("error"):
+			result.err = fmt.Errorf("%w: %w: %s: %s", auth.ErrAuthenticationFailed, ErrAuthorizationDenied, query.Get("error"), query.Get("error_description"))
+			http.Error(w, "Authorization denied. You can close this window.", http.StatusForbidden)
+		case query.Get("code") == "":
+			http.Error(w, "Missing authorization code.", http.StatusBadRequest)
+			return
+		default:
+			result.code = query.Get("code")
+			_, _ = fmt.Fprintln(w, "Authentication completed. You can close this window.")
+		}
+
+		select {
+		case results <- result:
+		default:
+		}
+	})
+
+	return mux
+}
+
+func printAuthorizationURL(_ context.Context, url string) error {
+	return printf("To authenticate, open %s\n", url)
+}
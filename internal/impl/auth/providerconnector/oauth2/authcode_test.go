@@ -0,0 +1,147 @@
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// callback sends the redirect the IdP would send to the loopback listener
+// for the given authorization page.
+func callback(t *testing.T, authURL string, query url.Values) int {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+
+	redirectURI := u.Query().Get("redirect_uri")
+	if !query.Has("state") {
+		query.Set("state", u.Query().Get("state"))
+	}
+
+	resp, err := http.Get(redirectURI + "?" + query.Encode())
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestAuthCodeConnector_RequestToken(t *testing.T) {
+	t.Run("should return a token once the code is received by the loopback listener", func(t *testing.T) {
+		// Given an identity provider
+		idp := SetupMockIdP(t, publicClientID)
+
+		// And a connector whose browser follows the authorization page
+		var opened []string
+		connector := NewAuthCodeConnector(publicClientID, idp.URL+"/auth", idp.URL+"/token", scopes, "127.0.0.1:0",
+			func(_ context.Context, authURL string) error {
+				opened = append(opened, authURL)
+
+				resp, err := http.Get(authURL)
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			}, nil)
+
+		// When we request a token
+		token, err := connector.RequestToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+
+		// And the code was exchanged along with its PKCE verifier
+		require.Equal(t, "access-token-1", token.AccessToken)
+		require.Equal(t, "refresh-token-1", token.RefreshToken)
+		require.Equal(t, []string{"authorization_code"}, idp.Grants)
+
+		// And the redirect URI is served on the loopback interface
+		require.Len(t, opened, 1)
+		u, err := url.Parse(opened[0])
+		require.NoError(t, err)
+		require.Regexp(t, `^http://127\.0\.0\.1:\d+/callback$`, u.Query().Get("redirect_uri"))
+
+		// When we request another token
+		token, err = connector.RequestToken(t.Context())
+
+		// Then it is refreshed without opening the browser again
+		require.NoError(t, err)
+		require.Equal(t, "access-token-2", token.AccessToken)
+		require.Len(t, opened, 1)
+	})
+
+	t.Run("should ignore callbacks with an invalid state", func(t *testing.T) {
+		// Given an identity provider
+		idp := SetupMockIdP(t, publicClientID)
+
+		// And a connector whose browser first receives a forged callback
+		var forged int
+		connector := NewAuthCodeConnector(publicClientID, idp.URL+"/auth", idp.URL+"/token", scopes, "127.0.0.1:0",
+			func(_ context.Context, authURL string) error {
+				forged = callback(t, authURL, url.Values{"code": {"forged"}, "state": {"forged"}})
+
+				resp, err := http.Get(authURL)
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			}, nil)
+
+		// When we request a token
+		token, err := connector.RequestToken(t.Context())
+
+		// Then the forged callback was rejected
+		require.Equal(t, http.StatusBadRequest, forged)
+
+		// And the genuine one was used
+		require.NoError(t, err)
+		require.Equal(t, "access-token-1", token.AccessToken)
+	})
+
+	t.Run("should report a denied authorization", func(t *testing.T) {
+		// Given an identity provider
+		idp := SetupMockIdP(t, publicClientID)
+
+		// And a connector whose user denies the authorization
+		connector := NewAuthCodeConnector(publicClientID, idp.URL+"/auth", idp.URL+"/token", scopes, "127.0.0.1:0",
+			func(_ context.Context, authURL string) error {
+				callback(t, authURL, url.Values{"error": {"access_denied"}, "error_description": {"user refused"}})
+				return nil
+			}, nil)
+
+		// When we request a token
+		_, err := connector.RequestToken(t.Context())
+
+		// Then an authentication failure is reported
+		require.ErrorIs(t, err, auth.ErrAuthenticationFailed)
+		require.ErrorIs(t, err, ErrAuthorizationDenied)
+		require.ErrorContains(t, err, "access_denied: user refused")
+
+		// And no token was requested
+		require.Empty(t, idp.Grants)
+	})
+
+	t.Run("should stop waiting when the context is done", func(t *testing.T) {
+		// Given an identity provider
+		idp := SetupMockIdP(t, publicClientID)
+
+		// And a connector whose user never authorizes the client
+		connector := NewAuthCodeConnector(publicClientID, idp.URL+"/auth", idp.URL+"/token", scopes, "127.0.0.1:0",
+			func(context.Context, string) error { return nil }, nil)
+
+		// When we request a token with a deadline
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := connector.RequestToken(ctx)
+
+		// Then the deadline is reported
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,98 @@
+package oauth2
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// DeviceAuthorization holds what the user needs to authorize a device: the
+// code to enter on the verification page.
+type DeviceAuthorization struct {
+	// UserCode is the code the user enters on the verification page.
+	UserCode string
+
+	// VerificationURI is the page where the user enters the code.
+	VerificationURI string
+
+	// VerificationURIComplete is the optional verification page embedding
+	// the code, e.g. to be rendered as a QR code.
+	VerificationURIComplete string
+
+	// Expiry is the time the codes expire at.
+	Expiry time.Time
+}
+
+// DevicePrompt shows the user how to authorize the device. It must return
+// without waiting for the authorization, which is polled for afterwards.
+type DevicePrompt func(ctx context.Context, authorization DeviceAuthorization) error
+
+// DeviceConnector implements auth.ProviderConnector.
+// It uses the OAuth2 Device Authorization Grant, for interactive tools running
+// where no browser is available: the user authorizes the tool from another
+// device. Refresh tokens are used to renew the token without involving the
+// user again.
+type DeviceConnector struct {
+	interactiveGrant
+
+	prompt DevicePrompt
+}
+
+var _ auth.ProviderConnector = (*DeviceConnector)(nil)
+
+// NewDeviceConnector creates a new connector instance for the public client
+// clientID. deviceAuthURL and tokenURL are the device authorization and
+// token endpoints of the IdP. prompt is called with the code of every new
+// authorization; a nil prompt prints the instructions on the standard
+// error. repository, which may be nil, is read for a refresh token saved by
+// a previous run.
+func NewDeviceConnector(clientID, deviceAuthURL, tokenURL string, scopes []string, prompt DevicePrompt, repository auth.TokenRepository, opts ...Option) *DeviceConnector {
+	if prompt == nil {
+		prompt = printDeviceAuthorization
+	}
+
+	return &DeviceConnector{
+		interactiveGrant: interactiveGrant{
+			config: newPublicClientConfig(clientID, oauth2.Endpoint{
+				DeviceAuthURL: deviceAuthURL,
+				TokenURL:      tokenURL,
+			}, scopes),
+			options:    newOptions(opts),
+			repository: repository,
+		},
+		prompt: prompt,
+	}
+}
+
+// RequestToken refreshes the token if possible, and asks the user to
+// authorize the device otherwise. It returns once the user has done so, the
+// codes have expired or ctx is done.
+func (c *DeviceConnector) RequestToken(ctx context.Context) (*auth.Token, error) {
+	return c.requestToken(ctx, c.authorize)
+}
+
+func (c *DeviceConnector) authorize(ctx context.Context) (*oauth2.Token, error) {
+	response, err := c.config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.prompt(ctx, DeviceAuthorization{
+		UserCode:                response.UserCode,
+		VerificationURI:         response.VerificationURI,
+		VerificationURIComplete: response.VerificationURIComplete,
+		Expiry:                  response.Expiry,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.config.DeviceAccessToken(ctx, response)
+}
+
+func printDeviceAuthorization(_ context.Context, authorization DeviceAuthorization) error {
+	return printf("To authenticate, open %s and enter the code %s\n", authorization.VerificationURI, authorization.UserCode)
+}
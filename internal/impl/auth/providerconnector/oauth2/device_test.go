@@ -0,0 +1,113 @@
+package oauth2
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+const publicClientID = "this_is_a_public_client_id"
+
+func TestDeviceConnector_RequestToken(t *testing.T) {
+	t.Run("should return a token once the user authorized the device", func(t *testing.T) {
+		// Given an identity provider
+		idp := SetupMockIdP(t, publicClientID)
+
+		// And a device connector recording the prompts
+		var prompts []DeviceAuthorization
+		connector := NewDeviceConnector(publicClientID, idp.URL+"/device", idp.URL+"/token", scopes,
+			func(_ context.Context, authorization DeviceAuthorization) error {
+				prompts = append(prompts, authorization)
+				return nil
+			}, nil)
+
+		// When we request a token
+		token, err := connector.RequestToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+
+		// And the user was asked to enter the code
+		require.Len(t, prompts, 1)
+		require.Equal(t, "ABCD-EFGH", prompts[0].UserCode)
+		require.Equal(t, idp.URL+"/activate", prompts[0].VerificationURI)
+
+		// And the token carries a refresh token
+		require.Equal(t, "access-token-1", token.AccessToken)
+		require.Equal(t, "refresh-token-1", token.RefreshToken)
+		require.False(t, token.Expiry.IsZero())
+
+		// When we request another token
+		token, err = connector.RequestToken(t.Context())
+
+		// Then it is refreshed without prompting the user again
+		require.NoError(t, err)
+		require.Equal(t, "access-token-2", token.AccessToken)
+		require.Len(t, prompts, 1)
+		require.Equal(t, []string{"urn:ietf:params:oauth:grant-type:device_code", "refresh_token"}, idp.Grants)
+	})
+
+	t.Run("should refresh the token saved in the repository by a previous run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an identity provider
+		idp := SetupMockIdP(t, publicClientID)
+
+		// And a repository holding an expired token and its refresh token
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(&auth.Token{AccessToken: "expired", RefreshToken: "refresh-token-0"}, nil).Times(1)
+
+		// And a device connector which must not prompt the user
+		connector := NewDeviceConnector(publicClientID, idp.URL+"/device", idp.URL+"/token", scopes,
+			func(context.Context, DeviceAuthorization) error {
+				t.Error("the user should not be prompted")
+				return nil
+			}, repository)
+
+		// When we request a token
+		token, err := connector.RequestToken(t.Context())
+
+		// Then it is obtained with the refresh token
+		require.NoError(t, err)
+		require.Equal(t, "access-token-1", token.AccessToken)
+		require.Equal(t, []string{"refresh_token"}, idp.Grants)
+	})
+
+	t.Run("should prompt the user again when the refresh token is revoked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an identity provider which revoked a refresh token
+		idp := SetupMockIdP(t, publicClientID)
+		idp.RevokedRefreshTokens = []string{"refresh-token-0"}
+
+		// And a repository holding that refresh token
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(&auth.Token{AccessToken: "expired", RefreshToken: "refresh-token-0"}, nil).Times(1)
+
+		// And a device connector writing the default prompt
+		var output bytes.Buffer
+		previous := stderr
+		stderr = &output
+		t.Cleanup(func() { stderr = previous })
+
+		connector := NewDeviceConnector(publicClientID, idp.URL+"/device", idp.URL+"/token", scopes, nil, repository)
+
+		// When we request a token
+		token, err := connector.RequestToken(t.Context())
+
+		// Then a new authorization is obtained
+		require.NoError(t, err)
+		require.Equal(t, "access-token-1", token.AccessToken)
+		require.Equal(t, []string{"refresh_token", "urn:ietf:params:oauth:grant-type:device_code"}, idp.Grants)
+
+		// And the user was prompted on the standard error
+		require.Equal(t, "To authenticate, open "+idp.URL+"/activate and enter the code ABCD-EFGH\n", output.String())
+	})
+}
@@ -0,0 +1,117 @@
+package oauth2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/oauth2"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// interactiveGrant holds what the connectors involving a user share: the
+// OAuth2 configuration of the public client and the refresh token, so that
+// the user is only involved again once it is missing, expired or revoked.
+type interactiveGrant struct {
+	config oauth2.Config
+	options
+
+	// repository persists the refresh token along with the access token. It
+	// is read when no refresh token has been obtained by this connector yet,
+	// e.g. after a restart. Nil means the refresh token is only kept in
+	// memory.
+	repository auth.TokenRepository
+
+	// locker guards refreshToken.
+	locker sync.Mutex
+	// refreshToken is the last refresh token obtained. It survives the
+	// invalidation of the token stored in the repository.
+	refreshToken string
+}
+
+// requestToken refreshes the token if a refresh token is available, and
+// obtains a new one through authorize otherwise, or if the refresh token is
+// rejected.
+func (g *interactiveGrant) requestToken(ctx context.Context, authorize func(ctx context.Context) (*oauth2.Token, error)) (*auth.Token, error) {
+	ctx = g.withHTTPClient(ctx)
+
+	if refreshToken := g.currentRefreshToken(ctx); refreshToken != "" {
+		oauth2Token, err := g.config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+		if err == nil {
+			return g.keep(oauth2Token), nil
+		}
+
+		// Only an answer of the token endpoint means the refresh token is no
+		// longer valid; the user would not get further otherwise.
+		if retrieveError := (&oauth2.RetrieveError{}); !errors.As(err, &retrieveError) {
+			return nil, fmt.Errorf("failed to refresh the token: %w", err)
+		}
+	}
+
+	oauth2Token, err := authorize(ctx)
+	if err != nil {
+		return nil, wrapOAuth2Error(err)
+	}
+
+	return g.keep(oauth2Token), nil
+}
+
+// currentRefreshToken returns the last refresh token obtained, or the one
+// stored in the repository.
+func (g *interactiveGrant) currentRefreshToken(ctx context.Context) string {
+	g.locker.Lock()
+	refreshToken := g.refreshToken
+	g.locker.Unlock()
+
+	if refreshToken != "" || g.repository == nil {
+		return refreshToken
+	}
+
+	token, err := g.repository.FetchToken(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return token.RefreshToken
+}
+
+// keep records the refresh token of oauth2Token, if any, and converts it.
+func (g *interactiveGrant) keep(oauth2Token *oauth2.Token) *auth.Token {
+	if oauth2Token.RefreshToken != "" {
+		g.locker.Lock()
+		g.refreshToken = oauth2Token.RefreshToken
+		g.locker.Unlock()
+	}
+
+	return &auth.Token{
+		AccessToken:  oauth2Token.AccessToken,
+		Expiry:       oauth2Token.Expiry,
+		RefreshToken: oauth2Token.RefreshToken,
+	}
+}
+
+// newPublicClientConfig returns the configuration of a public client, which
+// authenticates with its client ID only.
+func newPublicClientConfig(clientID string, endpoint oauth2.Endpoint, scopes []string) oauth2.Config {
+	endpoint.AuthStyle = oauth2.AuthStyleInParams
+
+	return oauth2.Config{
+		ClientID: clientID,
+		Endpoint: endpoint,
+		Scopes:   scopes,
+	}
+}
+
+// stderr is where the default prompts write. It is a variable so tests can
+// capture them.
+var stderr io.Writer = os.Stderr
+
+// printf writes a default prompt.
+func printf(format string, args ...any) error {
+	_, err := fmt.Fprintf(stderr, format, args...)
+	return err
+}
@@ -1,5 +1,7 @@
-// Package oauth2 provides an implementation of the auth.ProviderConnector interface
-// using the Client Credentials Flow (RFC 6749).
+// Package oauth2 provides implementations of the auth.ProviderConnector interface
+// using the Client Credentials Flow (RFC 6749), and for interactive tools the
+// Device Authorization Grant (RFC 8628) and the Authorization Code Grant with
+// PKCE (RFC 7636).
 // It acts as a bridge between the SDK's internal authentication ports and the
 // standard golang.org/x/oauth2 library.
 package oauth2
@@ -23,6 +25,42 @@ type ProviderConnector struct {
 	credentialsRepository auth.CredentialsRepository
 	tokenURL              string
 	scopes                []string
+	options
+}
+
+// Option configures optional behaviours of the connectors.
+type Option func(*options)
+
+// options holds the optional behaviours shared by the connectors.
+type options struct {
+	httpClient *http.Client
+}
+
+// WithHTTPClient sets the HTTP client reaching the token endpoint. A nil
+// client selects http.DefaultClient, which is the default.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// withHTTPClient returns a context making the oauth2 library use the
+// configured HTTP client, if any.
+func (o *options) withHTTPClient(ctx context.Context) context.Context {
+	if o.httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, o.httpClient)
+	}
+
+	return ctx
 }
 
 var _ auth.ProviderConnector = (*ProviderConnector)(nil)
@@ -30,11 +68,12 @@ var _ auth.ProviderConnector = (*ProviderConnector)(nil)
 // NewProviderConnector creates a new connector instance.
 // tokenURL is the specific endpoint of the Identity Provider (IdP).
 // scopes are the permissions requested for the token.
-func NewProviderConnector(credentialsRepository auth.CredentialsRepository, tokenURL string, scopes []string) *ProviderConnector {
+func NewProviderConnector(credentialsRepository auth.CredentialsRepository, tokenURL string, scopes []string, opts ...Option) *ProviderConnector {
 	return &ProviderConnector{
 		credentialsRepository: credentialsRepository,
 		tokenURL:              tokenURL,
 		scopes:                scopes,
+		options:               newOptions(opts),
 	}
 }
 
@@ -55,7 +94,7 @@ func (c *ProviderConnector) RequestToken(ctx context.Context) (*auth.Token, erro
 		Scopes:       c.scopes,
 	}
 
-	oauth2Token, err := oauth2Config.Token(ctx)
+	oauth2Token, err := oauth2Config.Token(c.withHTTPClient(ctx))
 	if err != nil {
 		return nil, wrapOAuth2Error(err)
 	}
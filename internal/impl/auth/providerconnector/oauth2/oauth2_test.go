@@ -11,7 +11,7 @@ import (
 	"github.com/Arubacloud/sdk-go/internal/ports/auth"
 )
 
-//go:generate mockgen -package oauth2 -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth CredentialsRepository
+//go:generate mockgen -package oauth2 -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth CredentialsRepository,TokenRepository
 
 var (
 	clientID     = "this_is_a_valid_client_id"
@@ -68,6 +68,55 @@ func TestProviderConnector_RequestToken(t *testing.T) {
 		require.InDelta(t, expiry.UTC().Unix(), token.Expiry.UTC().Unix(), 5.0) // 5 seconds of toleration
 	})
 
+	t.Run("should use the configured http client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a fully functional OAuth2 server
+		oauth2Server := SetupConfigurableTokenServer(t, MockServerConfig{
+			StatusCode: http.StatusOK,
+
+			AccessToken:  accessToken,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       scopes,
+			ExpiresIn:    expireIn,
+		})
+
+		defer oauth2Server.Close()
+
+		// And a fully functional credentials repository
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+
+		credentialsRepository.EXPECT().FetchCredentials(
+			gomock.AssignableToTypeOf(t.Context()),
+		).Return(&auth.Credentials{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		}, nil).Times(1)
+
+		// And an http client counting the requests it sends
+		requests := 0
+		httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			requests++
+			return http.DefaultTransport.RoundTrip(r)
+		})}
+
+		//
+		// And a Provider connector which use all previous components
+		providerConnector := NewProviderConnector(credentialsRepository, oauth2Server.URL, scopes, WithHTTPClient(httpClient))
+
+		// When we request a token
+		token, err := providerConnector.RequestToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+		require.Equal(t, accessToken, token.AccessToken)
+
+		// And the token request should be sent by the configured http client
+		require.Equal(t, 1, requests)
+	})
+
 	t.Run("should report a proper error for unauthorized", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -215,3 +264,9 @@ func TestProviderConnector_RequestToken(t *testing.T) {
 		require.Nil(t, token)
 	})
 }
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
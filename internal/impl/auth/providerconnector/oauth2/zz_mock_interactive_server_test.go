@@ -0,0 +1,109 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+// MockIdP is an identity provider serving the device authorization, the
+// authorization and the token endpoints of a public client.
+type MockIdP struct {
+	*httptest.Server
+
+	ClientID string
+
+	// RevokedRefreshTokens are rejected by the token endpoint.
+	RevokedRefreshTokens []string
+
+	mu sync.Mutex
+	// Grants records the grant types requested to the token endpoint.
+	Grants []string
+	// issued counts the tokens issued.
+	issued int
+	// challenges maps the authorization codes to their PKCE challenge.
+	challenges map[string]string
+}
+
+func SetupMockIdP(t *testing.T, clientID string) *MockIdP {
+	t.Helper()
+
+	idp := &MockIdP{ClientID: clientID, challenges: map[string]string{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /device", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, clientID, r.Form.Get("client_id"))
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": idp.URL + "/activate",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("GET /auth", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, clientID, query.Get("client_id"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+		code := fmt.Sprintf("code-%s", query.Get("state"))
+		idp.mu.Lock()
+		idp.challenges[code] = query.Get("code_challenge")
+		idp.mu.Unlock()
+
+		http.Redirect(w, r, query.Get("redirect_uri")+"?code="+code+"&state="+query.Get("state"), http.StatusFound)
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, clientID, r.Form.Get("client_id"))
+
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+
+		grant := r.Form.Get("grant_type")
+		idp.Grants = append(idp.Grants, grant)
+
+		switch grant {
+		case "refresh_token":
+			for _, revoked := range idp.RevokedRefreshTokens {
+				if r.Form.Get("refresh_token") == revoked {
+					writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant"})
+					return
+				}
+			}
+		case "authorization_code":
+			challenge, ok := idp.challenges[r.Form.Get("code")]
+			if !ok || oauth2.S256ChallengeFromVerifier(r.Form.Get("code_verifier")) != challenge {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant"})
+				return
+			}
+		}
+
+		idp.issued++
+		writeJSON(w, http.StatusOK, map[string]any{
+			"access_token":  fmt.Sprintf("access-token-%d", idp.issued),
+			"refresh_token": fmt.Sprintf("refresh-token-%d", idp.issued),
+			"token_type":    "Bearer",
+			"expires_in":    300,
+		})
+	})
+
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
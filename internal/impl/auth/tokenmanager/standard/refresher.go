@@ -0,0 +1,259 @@
+package standard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+)
+
+// backgroundRefresh configures the proactive renewal of the token.
+type backgroundRefresh struct {
+	// renewBefore is the fraction of the token lifetime, in (0, 1), left
+	// before its expiry when the token is renewed.
+	renewBefore float64
+
+	// minBackoff is the delay before retrying a failed renewal. It doubles
+	// after every subsequent failure, up to maxBackoff.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// jitter returns a pseudo-random number in [0.0, 1.0). It is a field so
+	// tests can make delays deterministic.
+	jitter func() float64
+}
+
+// issuedToken is a token obtained from the connector, identified by its
+// access token, and the time it was obtained.
+type issuedToken struct {
+	accessToken string
+	at          time.Time
+}
+
+// WithBackgroundRefresh enables the proactive renewal of the token by the
+// goroutine launched by Start: the token is renewed once only renewBefore (a
+// fraction in (0, 1)) of its lifetime is left before its expiry, so that
+// requests never wait for it. Failed renewals are retried after minBackoff,
+// doubling up to maxBackoff, and a token is never renewed less than
+// minBackoff after being obtained.
+//
+// The expiry is assumed to be enforced on the server clock: when the Date
+// header of the API responses shows that clock ahead of the local one, the
+// renewal is brought forward accordingly.
+func WithBackgroundRefresh(renewBefore float64, minBackoff, maxBackoff time.Duration) Option {
+	return func(m *TokenManager) {
+		m.backgroundRefresh = &backgroundRefresh{
+			renewBefore: renewBefore,
+			minBackoff:  minBackoff,
+			maxBackoff:  maxBackoff,
+			jitter:      rand.Float64,
+		}
+	}
+}
+
+// Start launches the background refresh, when enabled with
+// WithBackgroundRefresh and a provider connector is configured. A token is
+// obtained right away if the repository has no valid one. The refresh runs
+// until ctx is done or Stop is called; calling Start while it runs has no
+// effect.
+func (m *TokenManager) Start(ctx context.Context) {
+	if m.backgroundRefresh == nil || m.connector == nil {
+		return
+	}
+
+	m.runLocker.Lock()
+	defer m.runLocker.Unlock()
+
+	if m.done != nil {
+		return
+	}
+
+	ctx, m.stop = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+
+	go m.runBackgroundRefresh(ctx, m.done)
+}
+
+// Stop stops the background refresh, if running, and waits for it to
+// return. Tokens are then only renewed inline, once expired or rejected.
+func (m *TokenManager) Stop() {
+	m.runLocker.Lock()
+	defer m.runLocker.Unlock()
+
+	if m.done == nil {
+		return
+	}
+
+	m.stop()
+	<-m.done
+
+	m.stop, m.done = nil, nil
+}
+
+// runBackgroundRefresh renews the token whenever it is due, until ctx is
+// done.
+func (m *TokenManager) runBackgroundRefresh(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	var (
+		observed issuedToken // first observation of a token saved by others
+		failures int
+	)
+
+	for {
+		var (
+			delay time.Duration
+			ok    = true
+		)
+		if failures > 0 {
+			delay = m.backgroundRefresh.backoff(failures)
+		} else {
+			delay, ok = m.untilRefresh(ctx, &observed)
+		}
+
+		// Without a known expiry, the refresh is only rescheduled once a new
+		// token is obtained.
+		timer := time.NewTimer(delay)
+		if !ok {
+			timer.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-m.renewed:
+			timer.Stop()
+			failures = 0
+			continue
+		case <-timer.C:
+		}
+
+		if err := m.refreshIfDue(ctx, &observed); err != nil {
+			failures++
+			continue
+		}
+		failures = 0
+	}
+}
+
+// untilRefresh returns the delay before the token of the repository is due
+// for renewal, and false if it never expires.
+func (m *TokenManager) untilRefresh(ctx context.Context, observed *issuedToken) (time.Duration, bool) {
+	m.locker.RLock()
+	defer m.locker.RUnlock()
+
+	token, err := m.repository.FetchToken(ctx)
+	if err != nil {
+		return 0, true
+	}
+
+	refreshAt, ok := m.refreshTime(token, observed)
+	if !ok {
+		return 0, false
+	}
+
+	return max(time.Until(refreshAt), 0), true
+}
+
+// refreshIfDue renews the token, unless the one of the repository has been
+// replaced since and is not due for renewal yet.
+func (m *TokenManager) refreshIfDue(ctx context.Context, observed *issuedToken) error {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	token, err := m.repository.FetchToken(ctx)
+	if err != nil && !errors.Is(err, auth.ErrTokenNotFound) {
+		m.logger.Log(ctx, logger.LevelError, "Background token refresh failed", logger.F(logger.KeyError, err))
+		return fmt.Errorf("unexpected error: %w", err)
+	}
+
+	if err == nil {
+		if refreshAt, ok := m.refreshTime(token, observed); !ok || time.Now().Before(refreshAt) {
+			return nil
+		}
+	}
+
+	// Increment ticket, as in InjectToken, so that requests rejected with
+	// the previous token use the new one instead of renewing it again.
+	m.ticket++
+
+	if _, err := m.refreshToken(ctx, nil, false); err != nil {
+		return err
+	}
+
+	// Consume the notification of our own renewal.
+	select {
+	case <-m.renewed:
+	default:
+	}
+
+	return nil
+}
+
+// refreshTime returns the time the token should be renewed at, and false if
+// it never expires. The lifetime of a token saved by others, e.g. by another
+// process sharing the repository, is counted from its first observation.
+// The caller must hold the lock.
+func (m *TokenManager) refreshTime(token *auth.Token, observed *issuedToken) (time.Time, bool) {
+	if token.Expiry.IsZero() {
+		return time.Time{}, false
+	}
+
+	issuedAt := m.issued.at
+	if token.AccessToken != m.issued.accessToken {
+		if token.AccessToken != observed.accessToken {
+			*observed = issuedToken{accessToken: token.AccessToken, at: time.Now()}
+		}
+		issuedAt = observed.at
+	}
+
+	lifetime := token.Expiry.Sub(issuedAt)
+	refreshAt := token.Expiry.Add(-time.Duration(m.backgroundRefresh.renewBefore * float64(lifetime)))
+
+	// A server clock ahead of the local one makes the token expire earlier
+	// than expected; a server clock behind it is ignored, as the expiry may
+	// as well have been computed locally.
+	if skew := time.Duration(m.clockSkew.Load()); skew > 0 {
+		refreshAt = refreshAt.Add(-skew)
+	}
+
+	// A token due as soon as obtained, e.g. because of a large skew or an
+	// expiry in the past, is not renewed before minBackoff, so that the
+	// token issuer is not called in a tight loop.
+	if earliest := issuedAt.Add(m.backgroundRefresh.minBackoff); refreshAt.Before(earliest) {
+		refreshAt = earliest
+	}
+
+	return refreshAt, true
+}
+
+// backoff returns the delay before retrying the renewal after the given
+// number of consecutive failures, with a jitter of up to half of it.
+func (b *backgroundRefresh) backoff(failures int) time.Duration {
+	delay := b.minBackoff
+	for i := 1; i < failures && delay < b.maxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, b.maxBackoff)
+
+	return delay - time.Duration(b.jitter()*float64(delay/2))
+}
+
+// observeClockSkew measures the offset of the server clock from the Date
+// header of resp.
+func (m *TokenManager) observeClockSkew(resp *http.Response) {
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	// The Date header has a resolution of one second.
+	skew := date.Sub(time.Now().Truncate(time.Second))
+	m.clockSkew.Store(int64(skew))
+}
@@ -0,0 +1,211 @@
+package standard
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+func TestTokenManager_BackgroundRefresh(t *testing.T) {
+	t.Run("should obtain a token and renew it before it expires", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an empty repository
+		repository := newInvalidatingRepository(ctrl, "")
+		repository.token = nil
+
+		// And a connector issuing tokens valid for 400ms
+		var issued atomic.Int32
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+			issued.Add(1)
+			return &auth.Token{AccessToken: accessToken, Expiry: time.Now().Add(400 * time.Millisecond)}, nil
+		}).MinTimes(2)
+
+		// And a token manager renewing tokens halfway through their lifetime
+		tokenManager := NewTokenManager(connector, repository, WithBackgroundRefresh(0.5, 10*time.Millisecond, 100*time.Millisecond))
+
+		// When the background refresh runs for a while
+		tokenManager.Start(t.Context())
+		require.Eventually(t, func() bool { return issued.Load() >= 3 }, 2*time.Second, 10*time.Millisecond)
+		tokenManager.Stop()
+
+		// Then the token was never allowed to expire
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		require.NoError(t, tokenManager.InjectToken(t.Context(), r))
+		extractAndValidateToken(t, r, accessToken)
+	})
+
+	t.Run("should back off and retry when the renewal fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an empty repository
+		repository := newInvalidatingRepository(ctrl, "")
+		repository.token = nil
+
+		// And a connector failing twice before issuing a token
+		var calls atomic.Int32
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+			if calls.Add(1) <= 2 {
+				return nil, errors.New("idp down")
+			}
+			return &auth.Token{AccessToken: accessToken, Expiry: expiry}, nil
+		}).Times(3)
+
+		tokenManager := NewTokenManager(connector, repository, WithBackgroundRefresh(0.2, 10*time.Millisecond, 100*time.Millisecond))
+
+		// When the background refresh runs
+		tokenManager.Start(t.Context())
+		defer tokenManager.Stop()
+
+		// Then the token is eventually saved
+		require.Eventually(t, func() bool {
+			repository.mu.Lock()
+			defer repository.mu.Unlock()
+
+			return repository.token != nil
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should stop renewing tokens once stopped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an empty repository
+		repository := newInvalidatingRepository(ctrl, "")
+		repository.token = nil
+
+		// And a connector always failing
+		var calls atomic.Int32
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+			calls.Add(1)
+			return nil, errors.New("idp down")
+		}).MinTimes(1)
+
+		tokenManager := NewTokenManager(connector, repository, WithBackgroundRefresh(0.2, time.Millisecond, time.Millisecond))
+
+		// When the background refresh is stopped
+		tokenManager.Start(t.Context())
+		require.Eventually(t, func() bool { return calls.Load() > 0 }, time.Second, time.Millisecond)
+		tokenManager.Stop()
+
+		// Then the connector is not called anymore
+		stopped := calls.Load()
+		time.Sleep(20 * time.Millisecond)
+		require.Equal(t, stopped, calls.Load())
+
+		// And stopping it again has no effect
+		tokenManager.Stop()
+	})
+
+	t.Run("should not renew in a tight loop when the server clock is far ahead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an empty repository
+		repository := newInvalidatingRepository(ctrl, "")
+		repository.token = nil
+
+		// And a connector issuing tokens valid for an hour
+		var issued atomic.Int32
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+			issued.Add(1)
+			return &auth.Token{AccessToken: accessToken, Expiry: time.Now().Add(time.Hour)}, nil
+		}).MinTimes(1)
+
+		// And a token manager which has seen a server clock two hours ahead
+		tokenManager := NewTokenManager(connector, repository, WithBackgroundRefresh(0.5, 50*time.Millisecond, time.Second))
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Date", time.Now().Add(2*time.Hour).UTC().Format(http.TimeFormat))
+		tokenManager.observeClockSkew(resp)
+
+		// When the background refresh runs for a while
+		tokenManager.Start(t.Context())
+		time.Sleep(200 * time.Millisecond)
+		tokenManager.Stop()
+
+		// Then the token is renewed at most once per minimum backoff
+		require.LessOrEqual(t, issued.Load(), int32(5))
+	})
+
+	t.Run("should not start without a connector", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a static token manager with background refresh enabled
+		tokenManager := NewStaticTokenManager(NewMockTokenRepository(ctrl), WithBackgroundRefresh(0.2, time.Second, time.Minute))
+
+		// When it is started
+		tokenManager.Start(t.Context())
+
+		// Then nothing runs
+		require.Nil(t, tokenManager.done)
+	})
+}
+
+func TestTokenManager_RefreshTime(t *testing.T) {
+	issuedAt := time.Now()
+	token := &auth.Token{AccessToken: accessToken, Expiry: issuedAt.Add(time.Hour)}
+
+	tests := []struct {
+		name string
+		date time.Time
+		want time.Time
+	}{
+		{name: "no clock skew", want: issuedAt.Add(45 * time.Minute)},
+		{name: "server clock ahead", date: time.Now().Add(10 * time.Minute), want: issuedAt.Add(35 * time.Minute)},
+		{name: "server clock behind", date: time.Now().Add(-10 * time.Minute), want: issuedAt.Add(45 * time.Minute)},
+		{name: "server clock far ahead", date: time.Now().Add(2 * time.Hour), want: issuedAt.Add(time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given a token manager renewing tokens when a quarter of their
+			// lifetime is left
+			tokenManager := NewTokenManager(nil, nil, WithBackgroundRefresh(0.25, time.Second, time.Minute))
+			tokenManager.issued = issuedToken{accessToken: accessToken, at: issuedAt}
+
+			// And a response received from the server
+			resp := &http.Response{Header: http.Header{}}
+			if !tt.date.IsZero() {
+				resp.Header.Set("Date", tt.date.UTC().Format(http.TimeFormat))
+			}
+			tokenManager.observeClockSkew(resp)
+
+			// When the refresh time of the token is computed
+			refreshAt, ok := tokenManager.refreshTime(token, &issuedToken{})
+
+			// Then it accounts for a server clock ahead only, and never
+			// before the minimum backoff
+			require.True(t, ok)
+			require.WithinDuration(t, tt.want, refreshAt, 2*time.Second)
+		})
+	}
+}
+
+func TestBackgroundRefresh_Backoff(t *testing.T) {
+	// Given a background refresh without jitter
+	b := &backgroundRefresh{minBackoff: time.Second, maxBackoff: 5 * time.Second, jitter: func() float64 { return 0 }}
+
+	// Then the delay doubles after every failure, up to the maximum
+	for failures, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		require.Equal(t, want, b.backoff(failures+1))
+	}
+
+	// And the jitter shortens it by up to half
+	b.jitter = func() float64 { return 0.5 }
+	require.Equal(t, 750*time.Millisecond, b.backoff(1))
+}
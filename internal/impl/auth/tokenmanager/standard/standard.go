@@ -5,11 +5,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
 	"github.com/Arubacloud/sdk-go/internal/ports/interceptor"
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
 )
 
 // TokenManager is the standard implementation of auth.TokenManager.
@@ -24,36 +32,167 @@ type TokenManager struct {
 	// ticket is a counter used to detect if a token refresh has occurred
 	// between the time a read lock was released and a write lock was acquired.
 	ticket uint64
+
+	// tracer creates a span for every token refresh. Nil means no tracing.
+	tracer trace.Tracer
+
+	// clientTrace holds the hooks notified of every token obtained. Nil
+	// means no hooks.
+	clientTrace *clienttrace.ClientTrace
+
+	// logger records every token refresh.
+	logger logger.StructuredLogger
+
+	// backgroundRefresh configures the proactive renewal of the token. Nil
+	// means tokens are only renewed inline, once expired or rejected.
+	backgroundRefresh *backgroundRefresh
+
+	// issued records the last token obtained from the connector along with
+	// the time it was obtained, so that its lifetime is known. It is guarded
+	// by locker.
+	issued issuedToken
+
+	// renewed is signalled every time a token is obtained from the
+	// connector, so that the background refresh reschedules itself.
+	renewed chan struct{}
+
+	// clockSkew is the offset, in nanoseconds, of the server clock from the
+	// local one, as measured from the Date header of the API responses.
+	clockSkew atomic.Int64
+
+	// runLocker guards stop and done, which control the background refresh.
+	runLocker sync.Mutex
+	stop      context.CancelFunc
+	done      chan struct{}
+}
+
+// Option configures optional behaviours of the TokenManager.
+type Option func(*TokenManager)
+
+// WithTracerProvider sets the provider of the tracer used to trace token
+// refreshes. A nil provider disables tracing, which is the default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(m *TokenManager) {
+		if provider != nil {
+			m.tracer = provider.Tracer(tracerName)
+		}
+	}
+}
+
+// WithClientTrace sets the hooks notified every time a token is obtained,
+// either from the repository or from the provider connector.
+func WithClientTrace(trace *clienttrace.ClientTrace) Option {
+	return func(m *TokenManager) {
+		m.clientTrace = trace
+	}
 }
 
+// WithLogger sets the logger recording token refreshes. Printf-only loggers
+// are wrapped in a shim. By default nothing is logged.
+func WithLogger(l logger.Logger) Option {
+	return func(m *TokenManager) {
+		m.logger = logger.Structured(l)
+	}
+}
+
+// tracerName is the instrumentation scope name of the spans created by the
+// TokenManager.
+const tracerName = "github.com/Arubacloud/sdk-go"
+
 // Verify at compile-time that TokenManager implements auth.TokenManager.
 var _ auth.TokenManager = (*TokenManager)(nil)
 
 // NewTokenManager creates a new instance of TokenManager with the provided
 // repository (for caching) and connector (for fetching fresh tokens).
-func NewTokenManager(connector auth.ProviderConnector, repository auth.TokenRepository) *TokenManager {
-	return &TokenManager{
+func NewTokenManager(connector auth.ProviderConnector, repository auth.TokenRepository, opts ...Option) *TokenManager {
+	m := &TokenManager{
 		repository: repository,
 		connector:  connector,
+		logger:     logger.Structured(nil),
+		renewed:    make(chan struct{}, 1),
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
 }
 
 // NewTokenManager creates a new instance of TokenManager without a provider
 // connector.
-func NewStaticTokenManager(repository auth.TokenRepository) *TokenManager {
-	return &TokenManager{repository: repository}
+func NewStaticTokenManager(repository auth.TokenRepository, opts ...Option) *TokenManager {
+	return NewTokenManager(nil, repository, opts...)
 }
 
-// BindTo registers the InjectToken method as a callback function within the
-// provided Interceptable (e.g., an HTTP client middleware chain).
+// BindTo registers the token manager within the provided Interceptable
+// (e.g., an HTTP client middleware chain). Interceptables driven through
+// RoundTrip get the RoundTrip method, which also renews rejected tokens; the
+// others get the InjectToken method.
 func (m *TokenManager) BindTo(interceptable interceptor.Interceptable) error {
 	if interceptable == nil {
 		return fmt.Errorf("%w: not possible to bind to a nil interceptable", auth.ErrInvalidInterceptable)
 	}
 
+	if middlewareInterceptable, ok := interceptable.(interceptor.MiddlewareInterceptable); ok {
+		if _, ok := interceptable.(interceptor.RoundTripper); ok {
+			return middlewareInterceptable.BindMiddleware(m.RoundTrip)
+		}
+	}
+
 	return interceptable.Bind(m.InjectToken)
 }
 
+// RoundTrip injects a token into the request, as InjectToken does, before
+// sending it through next.
+//
+// When the response is a 401 although the token looked valid (e.g. it was
+// revoked, or the local clock is skewed), the stored token is invalidated, a
+// fresh one is requested to the provider connector and the request is
+// replayed once with it. Concurrent requests rejected with the same token
+// share a single renewal. If the renewal fails, the 401 response is
+// returned as is.
+func (m *TokenManager) RoundTrip(ctx context.Context, r *http.Request, next interceptor.RoundTripFunc) (*http.Response, error) {
+	ticket, err := m.injectToken(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", interceptor.ErrInterceptFuncFailed, err)
+	}
+
+	resp, err := next(ctx, r)
+	if err != nil {
+		return resp, err
+	}
+
+	m.observeClockSkew(resp)
+
+	if resp.StatusCode != http.StatusUnauthorized || m.connector == nil {
+		return resp, nil
+	}
+
+	// Requests whose body cannot be read again are not replayed.
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return resp, nil
+	}
+
+	token, err := m.renewToken(ctx, r, ticket)
+	if err != nil {
+		return resp, nil
+	}
+
+	replay := r.Clone(ctx)
+	if r.GetBody != nil {
+		if replay.Body, err = r.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	replay.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return next(ctx, replay)
+}
+
 // InjectToken retrieves a valid token and adds it to the request "Authorization" header.
 //
 // Logic Flow:
@@ -63,6 +202,18 @@ func (m *TokenManager) BindTo(interceptable interceptor.Interceptable) error {
 //     (preventing the "thundering herd" problem), while others simply wait and
 //     use the newly refreshed token.
 func (m *TokenManager) InjectToken(ctx context.Context, r *http.Request) error {
+	_, err := m.injectToken(ctx, r)
+	return err
+}
+
+// injectToken implements InjectToken. It returns the ticket matching the
+// injected token, so that RoundTrip can tell whether it has been renewed
+// since.
+func (m *TokenManager) injectToken(ctx context.Context, r *http.Request) (ticket uint64, err error) {
+	start := time.Now()
+	source := clienttrace.TokenFromRepository
+	defer func() { m.traceToken(ctx, source, err, start) }()
+
 	// Step 1: Optimistic Read
 	m.locker.RLock()
 
@@ -72,7 +223,7 @@ func (m *TokenManager) InjectToken(ctx context.Context, r *http.Request) error {
 	token, err := m.repository.FetchToken(ctx)
 	if err != nil && !errors.Is(err, auth.ErrTokenNotFound) {
 		m.locker.RUnlock()
-		return fmt.Errorf("unexpected error: %w", err)
+		return 0, fmt.Errorf("unexpected error: %w", err)
 	}
 
 	m.locker.RUnlock()
@@ -91,27 +242,138 @@ func (m *TokenManager) InjectToken(ctx context.Context, r *http.Request) error {
 			// Increment ticket so pending readers know a change happened.
 			m.ticket++
 
-			token, err = m.connector.RequestToken(ctx)
+			source = clienttrace.TokenRefreshed
+			token, err = m.refreshToken(ctx, r, false)
 			if err != nil {
-				return fmt.Errorf("unexpected error: %w", err)
-			}
-
-			if err := m.repository.SaveToken(ctx, token); err != nil {
-				return fmt.Errorf("unexpected error: %w", err)
+				return 0, err
 			}
 		} else {
 			// If the tickets don't match, another goroutine already performed the
 			// refresh while we were waiting for the lock.
 			// We can simply fetch the new token from the repository.
+			// The refresh may have failed though, leaving no valid token.
 			token, err = m.repository.FetchToken(ctx)
 			if err != nil && !errors.Is(err, auth.ErrTokenNotFound) {
-				return fmt.Errorf("unexpected error: %w", err)
+				return 0, fmt.Errorf("unexpected error: %w", err)
+			}
+			if token == nil || !token.IsValid() {
+				return 0, fmt.Errorf("%w: the token refresh failed", auth.ErrTokenNotFound)
 			}
 		}
+
+		currentTicket = m.ticket
 	}
 
 	// Step 3: Injection
 	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
 
-	return nil
+	return currentTicket, nil
+}
+
+// renewToken replaces the token rejected by the API, which was injected
+// with the given ticket, and returns the new one.
+//
+// As in InjectToken, the ticket ensures only one goroutine performs the
+// renewal: if it has changed, the rejected token has already been replaced
+// and the current one is fetched from the repository instead.
+//
+// The rejected token is only discarded once a new one has been issued, so
+// that a failed renewal leaves the repository as it was.
+func (m *TokenManager) renewToken(ctx context.Context, r *http.Request, ticket uint64) (token *auth.Token, err error) {
+	start := time.Now()
+	source := clienttrace.TokenFromRepository
+	defer func() { m.traceToken(ctx, source, err, start) }()
+
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	if ticket != m.ticket {
+		token, err = m.repository.FetchToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected error: %w", err)
+		}
+		return token, nil
+	}
+
+	m.ticket++
+
+	m.logger.Log(ctx, logger.LevelInfo, "Token rejected, renewing it",
+		logger.F(logger.KeyMethod, r.Method),
+		logger.F(logger.KeyPath, r.URL.Path),
+	)
+
+	source = clienttrace.TokenRefreshed
+	return m.refreshToken(ctx, r, true)
+}
+
+// refreshToken requests a fresh token to the provider and saves it into the
+// repository, within a span when tracing is enabled. The outcome is logged
+// along with the request that triggered the refresh, if any. When invalidate
+// is set, the stored token is discarded before saving the new one, provided
+// the repository is an auth.TokenInvalidator. The caller must hold the write
+// lock.
+func (m *TokenManager) refreshToken(ctx context.Context, r *http.Request, invalidate bool) (token *auth.Token, err error) {
+	start := time.Now()
+	defer func() {
+		var fields []logger.Field
+		if r != nil {
+			fields = append(fields, logger.F(logger.KeyMethod, r.Method), logger.F(logger.KeyPath, r.URL.Path))
+		}
+		fields = append(fields, logger.F(logger.KeyDuration, time.Since(start)))
+
+		if err != nil {
+			m.logger.Log(ctx, logger.LevelError, "Token refresh failed", append(fields, logger.F(logger.KeyError, err))...)
+			return
+		}
+		m.logger.Log(ctx, logger.LevelDebug, "Token refreshed", fields...)
+	}()
+
+	var span trace.Span
+	if m.tracer != nil {
+		ctx, span = m.tracer.Start(ctx, "TokenManager.RefreshToken")
+		defer span.End()
+	}
+
+	token, err = m.connector.RequestToken(ctx)
+	if err == nil && invalidate {
+		if invalidator, ok := m.repository.(auth.TokenInvalidator); ok {
+			err = invalidator.InvalidateToken(ctx)
+		}
+	}
+	if err == nil {
+		err = m.repository.SaveToken(ctx, token)
+	}
+
+	if err != nil {
+		if span != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return nil, fmt.Errorf("unexpected error: %w", err)
+	}
+
+	m.issued = issuedToken{accessToken: token.AccessToken, at: start}
+
+	// Wake the background refresh up, if running, so that it is rescheduled
+	// after the new token.
+	select {
+	case m.renewed <- struct{}{}:
+	default:
+	}
+
+	return token, nil
+}
+
+// traceToken notifies the hooks, if any, of the outcome of InjectToken or
+// renewToken.
+func (m *TokenManager) traceToken(ctx context.Context, source clienttrace.TokenSource, err error, start time.Time) {
+	if m.clientTrace == nil || m.clientTrace.TokenObtained == nil {
+		return
+	}
+
+	m.clientTrace.TokenObtained(ctx, clienttrace.TokenInfo{
+		Source:  source,
+		Err:     err,
+		Latency: time.Since(start),
+	})
 }
@@ -3,22 +3,30 @@ package standard
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"math/rand/v2"
 	"net/http"
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	gomock "go.uber.org/mock/gomock"
 
+	std_interceptor "github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
 	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
 	"github.com/Arubacloud/sdk-go/internal/ports/interceptor"
 )
 
-//go:generate mockgen -package standard -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth TokenRepository,ProviderConnector
+//go:generate mockgen -package standard -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth TokenRepository,ProviderConnector,TokenInvalidator
 //go:generate mockgen -package standard -destination=zz_mock_interceptor_test.go github.com/Arubacloud/sdk-go/internal/ports/interceptor Interceptable
 
 // Common parameters
@@ -73,6 +81,31 @@ func TestTokenManager_BindTo(t *testing.T) {
 		// Then no error should be reported
 		require.NoError(t, err)
 	})
+
+	t.Run("should bind as a round-trip middleware when supported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which contains a valid token
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(&auth.Token{AccessToken: accessToken, Expiry: expiry}, nil).Times(1)
+
+		// And a token manager bound to a round-trip capable interceptor
+		tokenManager := NewTokenManager(NewMockProviderConnector(ctrl), repository)
+		middleware := std_interceptor.NewInterceptor()
+		require.NoError(t, tokenManager.BindTo(middleware))
+
+		// When a request goes through the interceptor
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		resp, err := middleware.RoundTrip(t.Context(), r, func(_ context.Context, r *http.Request) (*http.Response, error) {
+			extractAndValidateToken(t, r, accessToken)
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		})
+
+		// Then the token is injected into the sent request
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
 }
 
 func TestTokenManager_InjectToken(t *testing.T) {
@@ -407,6 +440,44 @@ func TestTokenManager_InjectToken(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("should return an error to the requests waiting for a failed refresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which does not contains a token
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(nil, auth.ErrTokenNotFound).AnyTimes()
+
+		// And a slow connector failing to issue a token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+			time.Sleep(10 * time.Millisecond)
+			return nil, errors.New("idp down")
+		}).MinTimes(1)
+
+		tokenManager := NewTokenManager(connector, repository)
+
+		// When we try to inject a token 20 times simultaneously
+		var wg sync.WaitGroup
+		errs := make(chan error, 20)
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+				errs <- tokenManager.InjectToken(t.Context(), r)
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		// Then an error is reported to every request
+		for err := range errs {
+			require.Error(t, err)
+		}
+	})
+
 	t.Run("should return an error when save token fail", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -488,6 +559,448 @@ func TestTokenManager_InjectToken(t *testing.T) {
 	})
 }
 
+func TestTokenManager_Tracing(t *testing.T) {
+	t.Run("should trace the token refresh as a child of the request span", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which does not contains a token
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(nil, auth.ErrTokenNotFound).Times(1)
+		repository.EXPECT().SaveToken(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		// And a connector issuing a fresh token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(&auth.Token{AccessToken: accessToken, Expiry: expiry}, nil).Times(1)
+
+		// And a token manager tracing into a span recorder
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		tokenManager := NewTokenManager(connector, repository, WithTracerProvider(provider))
+
+		// When a token is injected into a request carrying a span
+		ctx, parent := provider.Tracer("test").Start(t.Context(), "request")
+		r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://www.aruba.it/", nil)
+		err := tokenManager.InjectToken(ctx, r)
+		parent.End()
+
+		// Then no error should be reported
+		require.NoError(t, err)
+
+		// And the refresh is traced as a child of the request span
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		require.Equal(t, "TokenManager.RefreshToken", spans[0].Name())
+		require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	})
+
+	t.Run("should record a failed refresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which does not contains a token
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(nil, auth.ErrTokenNotFound).Times(1)
+
+		// And a connector failing to issue a token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(nil, errors.New("idp down")).Times(1)
+
+		// And a token manager tracing into a span recorder
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		tokenManager := NewTokenManager(connector, repository, WithTracerProvider(provider))
+
+		// When a token is injected
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		err := tokenManager.InjectToken(t.Context(), r)
+
+		// Then the error is reported
+		require.ErrorContains(t, err, "idp down")
+
+		// And the refresh span is marked as failed
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+}
+
+func TestTokenManager_ClientTrace(t *testing.T) {
+	t.Run("should report a refreshed token and then a token from the repository", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which is empty at first
+		repository := NewMockTokenRepository(ctrl)
+		var saved *auth.Token
+		repository.EXPECT().FetchToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+			if saved == nil {
+				return nil, auth.ErrTokenNotFound
+			}
+			return saved, nil
+		}).Times(2)
+		repository.EXPECT().SaveToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token *auth.Token) error {
+			saved = token
+			return nil
+		}).Times(1)
+
+		// And a connector issuing a fresh token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(&auth.Token{AccessToken: accessToken, Expiry: expiry}, nil).Times(1)
+
+		// And a token manager reporting to a client trace
+		var sources []clienttrace.TokenSource
+		tokenManager := NewTokenManager(connector, repository, WithClientTrace(&clienttrace.ClientTrace{
+			TokenObtained: func(_ context.Context, info clienttrace.TokenInfo) {
+				require.NoError(t, info.Err)
+				sources = append(sources, info.Source)
+			},
+		}))
+
+		// When a token is injected into two requests
+		for range 2 {
+			r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+			require.NoError(t, tokenManager.InjectToken(t.Context(), r))
+		}
+
+		// Then the first token is reported as refreshed and the second one as
+		// read from the repository
+		require.Equal(t, []clienttrace.TokenSource{clienttrace.TokenRefreshed, clienttrace.TokenFromRepository}, sources)
+	})
+
+	t.Run("should report a failure to obtain a token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository failing unexpectedly
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(nil, errors.New("redis down")).Times(1)
+
+		// And a token manager reporting to a client trace
+		var reported error
+		tokenManager := NewTokenManager(NewMockProviderConnector(ctrl), repository, WithClientTrace(&clienttrace.ClientTrace{
+			TokenObtained: func(_ context.Context, info clienttrace.TokenInfo) { reported = info.Err },
+		}))
+
+		// When a token is injected
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		err := tokenManager.InjectToken(t.Context(), r)
+
+		// Then the failure is reported to the hook too
+		require.Error(t, err)
+		require.ErrorContains(t, reported, "redis down")
+	})
+}
+
+func TestTokenManager_RoundTrip(t *testing.T) {
+	t.Run("should renew a rejected token and replay the request once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which contains a token looking valid
+		repository := newInvalidatingRepository(ctrl, "revoked token")
+
+		// And which can invalidate it
+		repository.invalidator.EXPECT().InvalidateToken(gomock.Any()).Return(nil).Times(1)
+
+		// And a connector issuing a fresh token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(&auth.Token{AccessToken: accessToken, Expiry: expiry}, nil).Times(1)
+
+		// And a server rejecting the revoked token
+		server := &tokenCheckingServer{valid: accessToken}
+
+		// And a fresh token manager using both repository and connector
+		tokenManager := NewTokenManager(connector, repository)
+
+		// When a request with a body goes through the token manager
+		r, _ := http.NewRequest(http.MethodPost, "https://www.aruba.it/", strings.NewReader(`{"name":"vpc"}`))
+		resp, err := tokenManager.RoundTrip(t.Context(), r, server.roundTrip)
+
+		// Then no error should be reported
+		require.NoError(t, err)
+
+		// And the response to the replayed request is returned
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// And the request was sent twice, with the same body and the fresh token
+		require.Equal(t, []string{"revoked token", accessToken}, server.tokens)
+		require.Equal(t, []string{`{"name":"vpc"}`, `{"name":"vpc"}`}, server.bodies)
+
+		// And the fresh token was saved
+		require.Equal(t, accessToken, repository.token.AccessToken)
+	})
+
+	t.Run("should not replay the request more than once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which contains a token looking valid
+		repository := newInvalidatingRepository(ctrl, "revoked token")
+		repository.invalidator.EXPECT().InvalidateToken(gomock.Any()).Return(nil).Times(1)
+
+		// And a connector issuing tokens the server rejects as well
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(&auth.Token{AccessToken: "another revoked token", Expiry: expiry}, nil).Times(1)
+
+		server := &tokenCheckingServer{valid: accessToken}
+		tokenManager := NewTokenManager(connector, repository)
+
+		// When a request goes through the token manager
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		resp, err := tokenManager.RoundTrip(t.Context(), r, server.roundTrip)
+
+		// Then the second 401 is returned
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		require.Len(t, server.tokens, 2)
+	})
+
+	t.Run("should return the 401 response when the renewal fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which contains a token looking valid
+		repository := newInvalidatingRepository(ctrl, "revoked token")
+		repository.invalidator.EXPECT().InvalidateToken(gomock.Any()).Times(0)
+
+		// And a connector failing to issue a token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(nil, errors.New("idp down")).Times(1)
+
+		server := &tokenCheckingServer{valid: accessToken}
+		tokenManager := NewTokenManager(connector, repository)
+
+		// When a request goes through the token manager
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		resp, err := tokenManager.RoundTrip(t.Context(), r, server.roundTrip)
+
+		// Then the original 401 is returned, with its body still readable
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, "unauthorized", string(body))
+
+		// And the request was not replayed
+		require.Len(t, server.tokens, 1)
+
+		// And the repository still holds its token
+		require.Equal(t, "revoked token", repository.token.AccessToken)
+	})
+
+	t.Run("should not renew a static token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a static token manager whose token is rejected
+		repository := newInvalidatingRepository(ctrl, "revoked token")
+		repository.invalidator.EXPECT().InvalidateToken(gomock.Any()).Times(0)
+
+		server := &tokenCheckingServer{valid: accessToken}
+		tokenManager := NewStaticTokenManager(repository)
+
+		// When a request goes through the token manager
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		resp, err := tokenManager.RoundTrip(t.Context(), r, server.roundTrip)
+
+		// Then the 401 is returned without replaying the request
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		require.Len(t, server.tokens, 1)
+	})
+
+	t.Run("should share a single renewal between concurrent requests", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which contains a token looking valid
+		repository := newInvalidatingRepository(ctrl, "revoked token")
+		repository.invalidator.EXPECT().InvalidateToken(gomock.Any()).Return(nil).Times(1)
+
+		// And a connector issuing a fresh token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(&auth.Token{AccessToken: accessToken, Expiry: expiry}, nil).Times(1)
+
+		server := &tokenCheckingServer{valid: accessToken}
+		tokenManager := NewTokenManager(connector, repository)
+
+		// And requests all sent with the revoked token before any is rejected
+		var sent sync.WaitGroup
+		sent.Add(50)
+		next := func(ctx context.Context, r *http.Request) (*http.Response, error) {
+			if r.Header.Get(tokenKey) == tokenPrefix+"revoked token" {
+				sent.Done()
+				sent.Wait()
+			}
+			return server.roundTrip(ctx, r)
+		}
+
+		// When the requests go through the token manager simultaneously
+		var wg sync.WaitGroup
+		statuses := make(chan int, 50)
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+				resp, err := tokenManager.RoundTrip(t.Context(), r, next)
+				if err != nil {
+					statuses <- 0
+					return
+				}
+				statuses <- resp.StatusCode
+			}()
+		}
+		wg.Wait()
+		close(statuses)
+
+		// Then every request succeeds once replayed
+		for status := range statuses {
+			require.Equal(t, http.StatusOK, status)
+		}
+
+		// And the token was renewed only once
+		require.Equal(t, uint64(1), tokenManager.ticket)
+	})
+
+	t.Run("should return the 401 responses when a shared renewal fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given a repository which contains a token looking valid
+		repository := newInvalidatingRepository(ctrl, "revoked token")
+		repository.invalidator.EXPECT().InvalidateToken(gomock.Any()).Times(0)
+
+		// And a connector failing to issue a token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(nil, errors.New("idp down")).Times(1)
+
+		server := &tokenCheckingServer{valid: accessToken}
+		tokenManager := NewTokenManager(connector, repository)
+
+		// And requests all sent before any is rejected, the replays with the
+		// same token coming afterwards
+		var sent sync.WaitGroup
+		sent.Add(50)
+		var attempts atomic.Int32
+		next := func(ctx context.Context, r *http.Request) (*http.Response, error) {
+			if attempts.Add(1) <= 50 {
+				sent.Done()
+				sent.Wait()
+			}
+			return server.roundTrip(ctx, r)
+		}
+
+		// When the requests go through the token manager simultaneously
+		var wg sync.WaitGroup
+		statuses := make(chan int, 50)
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+				resp, err := tokenManager.RoundTrip(t.Context(), r, next)
+				if err != nil {
+					statuses <- 0
+					return
+				}
+				statuses <- resp.StatusCode
+			}()
+		}
+		wg.Wait()
+		close(statuses)
+
+		// Then every request gets a 401 response
+		for status := range statuses {
+			require.Equal(t, http.StatusUnauthorized, status)
+		}
+
+		// And the repository still holds its token
+		require.Equal(t, "revoked token", repository.token.AccessToken)
+	})
+}
+
+// invalidatingRepository is a mocked token repository which can invalidate
+// the token it holds.
+type invalidatingRepository struct {
+	*MockTokenRepository
+	invalidator *MockTokenInvalidator
+
+	mu    sync.Mutex
+	token *auth.Token
+}
+
+func newInvalidatingRepository(ctrl *gomock.Controller, accessToken string) *invalidatingRepository {
+	r := &invalidatingRepository{
+		MockTokenRepository: NewMockTokenRepository(ctrl),
+		invalidator:         NewMockTokenInvalidator(ctrl),
+		token:               &auth.Token{AccessToken: accessToken, Expiry: expiry},
+	}
+
+	r.EXPECT().FetchToken(gomock.Any()).DoAndReturn(func(context.Context) (*auth.Token, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.token == nil {
+			return nil, auth.ErrTokenNotFound
+		}
+		return r.token, nil
+	}).AnyTimes()
+	r.EXPECT().SaveToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token *auth.Token) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.token = token
+		return nil
+	}).AnyTimes()
+
+	return r
+}
+
+func (r *invalidatingRepository) InvalidateToken(ctx context.Context) error {
+	if err := r.invalidator.InvalidateToken(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.token = nil
+	return nil
+}
+
+// tokenCheckingServer is a RoundTripFunc answering 401 to the requests not
+// carrying the valid token. It records the tokens and bodies it receives.
+type tokenCheckingServer struct {
+	valid string
+
+	mu     sync.Mutex
+	tokens []string
+	bodies []string
+}
+
+func (s *tokenCheckingServer) roundTrip(_ context.Context, r *http.Request) (*http.Response, error) {
+	token := strings.TrimPrefix(r.Header.Get(tokenKey), tokenPrefix)
+
+	var body []byte
+	if r.Body != nil {
+		body, _ = io.ReadAll(r.Body)
+	}
+
+	s.mu.Lock()
+	s.tokens = append(s.tokens, token)
+	s.bodies = append(s.bodies, string(body))
+	s.mu.Unlock()
+
+	if token != s.valid {
+		return &http.Response{StatusCode: http.StatusUnauthorized, Body: io.NopCloser(strings.NewReader("unauthorized"))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
 func extractAndValidateToken(t *testing.T, r *http.Request, expectedToken string) {
 	t.Helper()
 
@@ -496,3 +1009,44 @@ func extractAndValidateToken(t *testing.T, r *http.Request, expectedToken string
 	require.True(t, strings.HasPrefix(r.Header[tokenKey][0], tokenPrefix))
 	require.Equal(t, expectedToken, strings.TrimPrefix(r.Header[tokenKey][0], tokenPrefix))
 }
+
+func TestTokenManager_Logger(t *testing.T) {
+	t.Run("should log the outcome of a refresh with the request fields", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given an empty repository
+		repository := NewMockTokenRepository(ctrl)
+		repository.EXPECT().FetchToken(gomock.Any()).Return(nil, auth.ErrTokenNotFound).Times(1)
+
+		// And a connector failing to issue a token
+		connector := NewMockProviderConnector(ctrl)
+		connector.EXPECT().RequestToken(gomock.Any()).Return(nil, errors.New("idp down")).Times(1)
+
+		// And a token manager writing to a printf-only logger
+		log := &printfLogger{}
+		tokenManager := NewTokenManager(connector, repository, WithLogger(log))
+
+		// When a token is injected
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/projects/p-1", nil)
+		err := tokenManager.InjectToken(t.Context(), r)
+
+		// Then the failure is logged with the request method and path
+		require.Error(t, err)
+		require.Len(t, log.errors, 1)
+		require.Contains(t, log.errors[0], "Token refresh failed method=GET path=/projects/p-1 duration=")
+		require.Contains(t, log.errors[0], `error="unexpected error: idp down"`)
+	})
+}
+
+// printfLogger is a printf-only logger.Logger recording the error lines.
+type printfLogger struct {
+	errors []string
+}
+
+func (l *printfLogger) Debugf(format string, args ...interface{}) {}
+func (l *printfLogger) Infof(format string, args ...interface{})  {}
+func (l *printfLogger) Warnf(format string, args ...interface{})  {}
+func (l *printfLogger) Errorf(format string, args ...interface{}) {
+	l.errors = append(l.errors, fmt.Sprintf(format, args...))
+}
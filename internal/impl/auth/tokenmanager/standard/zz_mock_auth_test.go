@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Arubacloud/sdk-go/internal/ports/auth (interfaces: TokenRepository,ProviderConnector)
+// Source: github.com/Arubacloud/sdk-go/internal/ports/auth (interfaces: TokenRepository,ProviderConnector,TokenInvalidator)
 //
 // Generated by this command:
 //
-//	mockgen -package standard -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth TokenRepository,ProviderConnector
+//	mockgen -package standard -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth TokenRepository,ProviderConnector,TokenInvalidator
 //
 
 // Package standard is a generated GoMock package.
@@ -108,3 +108,41 @@ func (mr *MockProviderConnectorMockRecorder) RequestToken(ctx any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestToken", reflect.TypeOf((*MockProviderConnector)(nil).RequestToken), ctx)
 }
+
+// MockTokenInvalidator is a mock of TokenInvalidator interface.
+type MockTokenInvalidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenInvalidatorMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenInvalidatorMockRecorder is the mock recorder for MockTokenInvalidator.
+type MockTokenInvalidatorMockRecorder struct {
+	mock *MockTokenInvalidator
+}
+
+// NewMockTokenInvalidator creates a new mock instance.
+func NewMockTokenInvalidator(ctrl *gomock.Controller) *MockTokenInvalidator {
+	mock := &MockTokenInvalidator{ctrl: ctrl}
+	mock.recorder = &MockTokenInvalidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenInvalidator) EXPECT() *MockTokenInvalidatorMockRecorder {
+	return m.recorder
+}
+
+// InvalidateToken mocks base method.
+func (m *MockTokenInvalidator) InvalidateToken(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateToken", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateToken indicates an expected call of InvalidateToken.
+func (mr *MockTokenInvalidatorMockRecorder) InvalidateToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateToken", reflect.TypeOf((*MockTokenInvalidator)(nil).InvalidateToken), ctx)
+}
@@ -19,6 +19,7 @@ type TokenRepository struct {
 }
 
 var _ auth.TokenRepository = (*TokenRepository)(nil)
+var _ auth.TokenInvalidator = (*TokenRepository)(nil)
 
 // NewFileTokenRepository is the constructor for TokenRepository.
 // It constructs the full file path where the token will be stored.
@@ -75,3 +76,12 @@ func (tr *TokenRepository) SaveToken(ctx context.Context, token *auth.Token) err
 	// Write the JSON data to the file path.
 	return os.WriteFile(tr.path, tokenJSON, 0o600)
 }
+
+// InvalidateToken removes the token file. A missing file is not an error.
+func (tr *TokenRepository) InvalidateToken(ctx context.Context) error {
+	if err := os.Remove(tr.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+
+	return nil
+}
@@ -83,3 +83,28 @@ func TestTokenRepository_SaveToken(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestTokenRepository_InvalidateToken(t *testing.T) {
+	t.Run("should remove the saved token", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := NewFileTokenRepository("user-123", dir)
+
+		err := repo.SaveToken(t.Context(), &auth.Token{AccessToken: accessToken, Expiry: expiry})
+		require.NoError(t, err)
+
+		err = repo.InvalidateToken(t.Context())
+		require.NoError(t, err)
+
+		token, err := repo.FetchToken(t.Context())
+		require.ErrorIs(t, err, auth.ErrTokenNotFound)
+		require.Nil(t, token)
+	})
+
+	t.Run("should not fail when no token file", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := NewFileTokenRepository("user-123", dir)
+
+		err := repo.InvalidateToken(t.Context())
+		require.NoError(t, err)
+	})
+}
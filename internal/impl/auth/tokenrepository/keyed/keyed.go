@@ -0,0 +1,94 @@
+// Package keyed provides an implementation of the auth.TokenRepository
+// storing the token under the client ID of the credentials in use, when they
+// are only known once fetched (e.g. from a credentials chain).
+package keyed
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+// TokenRepository is a struct that implements the auth.TokenRepository
+// interface by delegating to the repository of the current client ID.
+type TokenRepository struct {
+	credentialsRepository auth.CredentialsRepository
+	newRepository         func(clientID string) auth.TokenRepository
+
+	// locker guards the client ID and the repository resolved for it.
+	locker     sync.Mutex
+	clientID   string
+	repository auth.TokenRepository
+}
+
+var _ auth.TokenRepository = (*TokenRepository)(nil)
+var _ auth.TokenInvalidator = (*TokenRepository)(nil)
+
+// NewTokenRepository is the constructor for TokenRepository. The credentials
+// are fetched from credentialsRepository on every call, so it should cache
+// them; newRepository builds the repository of a client ID, again whenever it
+// changes.
+func NewTokenRepository(credentialsRepository auth.CredentialsRepository, newRepository func(clientID string) auth.TokenRepository) *TokenRepository {
+	return &TokenRepository{
+		credentialsRepository: credentialsRepository,
+		newRepository:         newRepository,
+	}
+}
+
+// FetchToken retrieves the token of the current client ID. Credentials which
+// cannot be fetched are reported as auth.ErrTokenNotFound, so that the
+// provider connector reports why.
+func (r *TokenRepository) FetchToken(ctx context.Context) (*auth.Token, error) {
+	repository, err := r.resolve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", auth.ErrTokenNotFound, err)
+	}
+
+	return repository.FetchToken(ctx)
+}
+
+// SaveToken stores the token under the current client ID.
+func (r *TokenRepository) SaveToken(ctx context.Context, token *auth.Token) error {
+	repository, err := r.resolve(ctx)
+	if err != nil {
+		return err
+	}
+
+	return repository.SaveToken(ctx, token)
+}
+
+// InvalidateToken discards the token of the current client ID, if its
+// repository supports it.
+func (r *TokenRepository) InvalidateToken(ctx context.Context) error {
+	repository, err := r.resolve(ctx)
+	if err != nil {
+		return err
+	}
+
+	if invalidator, ok := repository.(auth.TokenInvalidator); ok {
+		return invalidator.InvalidateToken(ctx)
+	}
+
+	return nil
+}
+
+// resolve returns the repository of the client ID of the current
+// credentials, building it if the client ID has changed.
+func (r *TokenRepository) resolve(ctx context.Context) (auth.TokenRepository, error) {
+	credentials, err := r.credentialsRepository.FetchCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	if r.repository == nil || r.clientID != credentials.ClientID {
+		r.clientID = credentials.ClientID
+		r.repository = r.newRepository(credentials.ClientID)
+	}
+
+	return r.repository, nil
+}
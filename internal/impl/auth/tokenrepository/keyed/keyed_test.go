@@ -0,0 +1,74 @@
+package keyed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/auth/tokenrepository/memory"
+	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+)
+
+//go:generate mockgen -package keyed -destination=zz_mock_auth_test.go github.com/Arubacloud/sdk-go/internal/ports/auth CredentialsRepository
+
+func TestTokenRepository(t *testing.T) {
+	token := &auth.Token{AccessToken: "this is a valid token", Expiry: time.Now().Add(24 * time.Hour)}
+
+	t.Run("should store the token under the client ID of the current credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given credentials moving from a client ID to another
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+		gomock.InOrder(
+			credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{ClientID: "env-id"}, nil).Times(2),
+			credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(&auth.Credentials{ClientID: "profile-id"}, nil).Times(1),
+		)
+
+		// And a repository per client ID
+		repositories := map[string]*memory.TokenRepository{}
+		tokenRepository := NewTokenRepository(credentialsRepository, func(clientID string) auth.TokenRepository {
+			repositories[clientID] = memory.NewTokenRepository()
+			return repositories[clientID]
+		})
+
+		// When we save a token and fetch it back
+		require.NoError(t, tokenRepository.SaveToken(t.Context(), token))
+		saved, err := tokenRepository.FetchToken(t.Context())
+
+		// Then it is the one of the first client ID
+		require.NoError(t, err)
+		require.Equal(t, token.AccessToken, saved.AccessToken)
+
+		// And the second client ID has no token
+		_, err = tokenRepository.FetchToken(t.Context())
+		require.ErrorIs(t, err, auth.ErrTokenNotFound)
+		require.Len(t, repositories, 2)
+	})
+
+	t.Run("should report a token not found when the credentials are missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Given no credentials
+		credentialsRepository := NewMockCredentialsRepository(ctrl)
+		credentialsRepository.EXPECT().FetchCredentials(gomock.Any()).Return(nil, auth.ErrCredentialsNotFound).Times(2)
+
+		tokenRepository := NewTokenRepository(credentialsRepository, func(string) auth.TokenRepository {
+			t.Fatal("no repository should be built")
+			return nil
+		})
+
+		// When we fetch the token
+		_, err := tokenRepository.FetchToken(t.Context())
+
+		// Then it is reported as not found, so that it is requested
+		require.ErrorIs(t, err, auth.ErrTokenNotFound)
+
+		// And saving fails
+		err = tokenRepository.SaveToken(t.Context(), token)
+		require.ErrorIs(t, err, auth.ErrCredentialsNotFound)
+	})
+}
@@ -41,6 +41,7 @@ type TokenRepository struct {
 }
 
 var _ auth.TokenRepository = (*TokenRepository)(nil)
+var _ auth.TokenInvalidator = (*TokenRepository)(nil)
 
 // NewTokenRepository creates a standalone in-memory repository.
 // Tokens stored here are lost when the application restarts.
@@ -170,6 +171,26 @@ func (r *TokenRepository) SaveToken(ctx context.Context, token *auth.Token) erro
 	return nil
 }
 
+// InvalidateToken discards the cached token and, when the persistent
+// repository supports it, the persisted one.
+func (r *TokenRepository) InvalidateToken(ctx context.Context) error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	if invalidator, ok := r.persistentRepository.(auth.TokenInvalidator); ok {
+		if err := invalidator.InvalidateToken(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Bump saveTicket so that in-flight FetchToken calls do not restore the
+	// discarded token.
+	r.saveTicket++
+	r.token = nil
+
+	return nil
+}
+
 func (r *TokenRepository) tokenCopyWithDrift() *auth.Token {
 	tokenCopy := r.token.Copy()
 
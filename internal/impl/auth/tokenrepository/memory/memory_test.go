@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 
+	"github.com/Arubacloud/sdk-go/internal/impl/auth/tokenrepository/file"
 	"github.com/Arubacloud/sdk-go/internal/ports/auth"
 )
 
@@ -627,6 +628,47 @@ func TestTokenProxy_SaveToken(t *testing.T) {
 	})
 }
 
+func TestTokenProxy_InvalidateToken(t *testing.T) {
+	t.Run("should discard its token", func(t *testing.T) {
+		// Given a repository which contains a valid token
+		repository := NewTokenRepositoryWithAccessToken(accessToken)
+
+		// When we invalidate its token
+		err := repository.InvalidateToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+
+		// And the repository should not contain a token anymore
+		token, err := repository.FetchToken(t.Context())
+		require.ErrorIs(t, err, auth.ErrTokenNotFound)
+		require.Nil(t, token)
+	})
+
+	t.Run("should invalidate the token of the persistent repository", func(t *testing.T) {
+		// Given a persistent repository which supports invalidation
+		persistentRepository := file.NewFileTokenRepository("user-123", t.TempDir())
+
+		// And a proxy using that last and that contains a valid token
+		proxy := NewTokenProxy(persistentRepository)
+		require.NoError(t, proxy.SaveToken(t.Context(), &auth.Token{AccessToken: accessToken, Expiry: expiry}))
+
+		// When we invalidate the token of the proxy
+		err := proxy.InvalidateToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+
+		// And neither the proxy nor the persistent repository should contain
+		// a token anymore
+		_, err = persistentRepository.FetchToken(t.Context())
+		require.ErrorIs(t, err, auth.ErrTokenNotFound)
+
+		_, err = proxy.FetchToken(t.Context())
+		require.ErrorIs(t, err, auth.ErrTokenNotFound)
+	})
+}
+
 func TestTokenProxyWithRandonExpirationDriftSeconds_FetchToken(t *testing.T) {
 	t.Run("should apply the drift to its im-memory token", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -13,9 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	gomock "go.uber.org/mock/gomock"
-
 	auth "github.com/Arubacloud/sdk-go/internal/ports/auth"
+	gomock "go.uber.org/mock/gomock"
 )
 
 // MockTokenRepository is a mock of TokenRepository interface.
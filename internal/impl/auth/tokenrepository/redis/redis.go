@@ -35,9 +35,11 @@ type RedisAdapter struct {
 type RedisCmdClient interface {
 	Get(ctx context.Context, key string) *redis.StringCmd
 	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
 }
 
 var _ auth.TokenRepository = (*TokenRepository)(nil)
+var _ auth.TokenInvalidator = (*TokenRepository)(nil)
 var _ RedisClient = (*RedisAdapter)(nil)
 
 // RedisClient defines minimal Redis operations used by TokenRepository.
@@ -45,6 +47,7 @@ var _ RedisClient = (*RedisAdapter)(nil)
 type RedisClient interface {
 	Get(ctx context.Context, key string) (string, error)
 	Set(ctx context.Context, key string, value any, expiration time.Duration) error
+	Del(ctx context.Context, key string) error
 }
 
 // NewRedisTokenRepository is the constructor for TokenRepository.
@@ -108,6 +111,11 @@ func (tr *TokenRepository) SaveToken(ctx context.Context, token *auth.Token) err
 	return err
 }
 
+// InvalidateToken deletes the token stored in Redis for the given client ID.
+func (tr *TokenRepository) InvalidateToken(ctx context.Context) error {
+	return tr.redisClient.Del(ctx, tr.clientID)
+}
+
 // Get retrieves a key from Redis and returns a decoded string.
 //
 // Behavior:
@@ -143,3 +151,8 @@ func (a *RedisAdapter) Set(ctx context.Context, key string, value any, expiratio
 	// Simply return any error from the command execution
 	return cmd.Err()
 }
+
+// Del deletes a key from Redis. Deleting a missing key is not an error.
+func (a *RedisAdapter) Del(ctx context.Context, key string) error {
+	return a.client.Del(ctx, key).Err()
+}
@@ -170,6 +170,45 @@ func TestTokenRepository_SaveToken(t *testing.T) {
 
 }
 
+func TestTokenRepository_InvalidateToken(t *testing.T) {
+
+	t.Run("should delete the token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRedis := NewMockRedisClient(ctrl)
+		tokenRepository := NewRedisTokenRepository("user-123", mockRedis)
+
+		mockRedis.
+			EXPECT().
+			Del(gomock.Any(), "user-123").
+			Return(nil)
+
+		// When we try to invalidate the token
+		err := tokenRepository.InvalidateToken(t.Context())
+
+		// Then no error should be reported
+		require.NoError(t, err)
+	})
+
+	t.Run("should report an error when redis connection fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRedis := NewMockRedisClient(ctrl)
+		tokenRepository := NewRedisTokenRepository("user-123", mockRedis)
+
+		mockRedis.
+			EXPECT().
+			Del(gomock.Any(), "user-123").
+			Return(errors.New("redis connection error"))
+
+		err := tokenRepository.InvalidateToken(t.Context())
+
+		require.Error(t, err)
+	})
+}
+
 func TestAdapter_Get(t *testing.T) {
 
 	t.Run("should return error when redis GET fails", func(t *testing.T) {
@@ -280,3 +319,44 @@ func TestAdapter_Set(t *testing.T) {
 		require.Equal(t, "redis connection error", err.Error())
 	})
 }
+
+func TestAdapter_Del(t *testing.T) {
+
+	t.Run("should return nil when redis DEL succeeds", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRedis := NewMockRedisCmdClient(ctrl)
+		adapter := NewRedisAdapter(mockRedis)
+
+		mockRedis.
+			EXPECT().
+			Del(gomock.Any(), "my-key").
+			Return(redis.NewIntCmd(t.Context()))
+
+		err := adapter.Del(t.Context(), "my-key")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when redis DEL fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRedis := NewMockRedisCmdClient(ctrl)
+		adapter := NewRedisAdapter(mockRedis)
+
+		cmd := redis.NewIntCmd(t.Context())
+		cmd.SetErr(errors.New("redis connection error"))
+
+		mockRedis.
+			EXPECT().
+			Del(gomock.Any(), "my-key").
+			Return(cmd)
+
+		err := adapter.Del(t.Context(), "my-key")
+
+		require.Error(t, err)
+		require.Equal(t, "redis connection error", err.Error())
+	})
+}
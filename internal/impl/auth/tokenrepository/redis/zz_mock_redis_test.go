@@ -22,6 +22,7 @@ import (
 type MockRedisClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockRedisClientMockRecorder
+	isgomock struct{}
 }
 
 // MockRedisClientMockRecorder is the mock recorder for MockRedisClient.
@@ -41,39 +42,54 @@ func (m *MockRedisClient) EXPECT() *MockRedisClientMockRecorder {
 	return m.recorder
 }
 
+// Del mocks base method.
+func (m *MockRedisClient) Del(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Del", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockRedisClientMockRecorder) Del(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockRedisClient)(nil).Del), ctx, key)
+}
+
 // Get mocks base method.
-func (m *MockRedisClient) Get(arg0 context.Context, arg1 string) (string, error) {
+func (m *MockRedisClient) Get(ctx context.Context, key string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret := m.ctrl.Call(m, "Get", ctx, key)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockRedisClientMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+func (mr *MockRedisClientMockRecorder) Get(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisClient)(nil).Get), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisClient)(nil).Get), ctx, key)
 }
 
 // Set mocks base method.
-func (m *MockRedisClient) Set(arg0 context.Context, arg1 string, arg2 any, arg3 time.Duration) error {
+func (m *MockRedisClient) Set(ctx context.Context, key string, value any, expiration time.Duration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Set", ctx, key, value, expiration)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockRedisClientMockRecorder) Set(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRedisClientMockRecorder) Set(ctx, key, value, expiration any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedisClient)(nil).Set), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedisClient)(nil).Set), ctx, key, value, expiration)
 }
 
 // MockRedisCmdClient is a mock of RedisCmdClient interface.
 type MockRedisCmdClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockRedisCmdClientMockRecorder
+	isgomock struct{}
 }
 
 // MockRedisCmdClientMockRecorder is the mock recorder for MockRedisCmdClient.
@@ -93,30 +109,49 @@ func (m *MockRedisCmdClient) EXPECT() *MockRedisCmdClientMockRecorder {
 	return m.recorder
 }
 
+// Del mocks base method.
+func (m *MockRedisCmdClient) Del(ctx context.Context, keys ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Del", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockRedisCmdClientMockRecorder) Del(ctx any, keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockRedisCmdClient)(nil).Del), varargs...)
+}
+
 // Get mocks base method.
-func (m *MockRedisCmdClient) Get(arg0 context.Context, arg1 string) *redis.StringCmd {
+func (m *MockRedisCmdClient) Get(ctx context.Context, key string) *redis.StringCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret := m.ctrl.Call(m, "Get", ctx, key)
 	ret0, _ := ret[0].(*redis.StringCmd)
 	return ret0
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockRedisCmdClientMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+func (mr *MockRedisCmdClientMockRecorder) Get(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisCmdClient)(nil).Get), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisCmdClient)(nil).Get), ctx, key)
 }
 
 // Set mocks base method.
-func (m *MockRedisCmdClient) Set(arg0 context.Context, arg1 string, arg2 any, arg3 time.Duration) *redis.StatusCmd {
+func (m *MockRedisCmdClient) Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Set", ctx, key, value, expiration)
 	ret0, _ := ret[0].(*redis.StatusCmd)
 	return ret0
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockRedisCmdClientMockRecorder) Set(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockRedisCmdClientMockRecorder) Set(ctx, key, value, expiration any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedisCmdClient)(nil).Set), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedisCmdClient)(nil).Set), ctx, key, value, expiration)
 }
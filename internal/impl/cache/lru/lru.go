@@ -0,0 +1,101 @@
+// Package lru provides an in-memory implementation of the cache.Cache,
+// evicting the least recently used entries once it is full.
+package lru
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/cache"
+)
+
+// Cache is a thread-safe, size-bounded in-memory cache.
+type Cache struct {
+	maxEntries int
+
+	locker sync.Mutex
+	// order lists the keys from the most to the least recently used.
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+var _ cache.Cache = (*Cache)(nil)
+
+// item is the value of the order list elements.
+type item struct {
+	key   string
+	entry *cache.Entry
+}
+
+// NewCache creates a cache holding up to maxEntries entries.
+// A non-positive maxEntries defaults to 1.
+func NewCache(maxEntries int) *Cache {
+	return &Cache{
+		maxEntries: max(maxEntries, 1),
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements cache.Cache.
+func (c *Cache) Get(key string) (*cache.Entry, bool) {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return element.Value.(*item).entry, true
+}
+
+// Set implements cache.Cache.
+func (c *Cache) Set(key string, entry *cache.Entry) {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*item).entry = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&item{key: key, entry: entry})
+
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// Invalidate implements cache.Cache.
+func (c *Cache) Invalidate(path string) {
+	path = strings.TrimSuffix(path, "/")
+
+	c.locker.Lock()
+	defer c.locker.Unlock()
+
+	for key, element := range c.entries {
+		keyPath, _, _ := strings.Cut(key, "?")
+		if keyPath == path || strings.HasPrefix(keyPath, path+"/") {
+			c.remove(element)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache) Len() int {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+
+	return c.order.Len()
+}
+
+// remove deletes an element. It must be called with the locker held.
+func (c *Cache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*item).key)
+}
@@ -0,0 +1,76 @@
+package lru
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/cache"
+)
+
+func TestCache_Set(t *testing.T) {
+	t.Run("should evict the least recently used entry", func(t *testing.T) {
+		// Given a full cache of 2 entries
+		c := NewCache(2)
+		c.Set("/a", &cache.Entry{ETag: `"a"`})
+		c.Set("/b", &cache.Entry{ETag: `"b"`})
+
+		// When the oldest entry is read and a third one is stored
+		_, ok := c.Get("/a")
+		require.True(t, ok)
+		c.Set("/c", &cache.Entry{ETag: `"c"`})
+
+		// Then the least recently used entry is evicted
+		_, ok = c.Get("/b")
+		require.False(t, ok)
+		_, ok = c.Get("/a")
+		require.True(t, ok)
+		_, ok = c.Get("/c")
+		require.True(t, ok)
+		require.Equal(t, 2, c.Len())
+	})
+
+	t.Run("should replace an existing entry", func(t *testing.T) {
+		// Given a cached entry
+		c := NewCache(2)
+		c.Set("/a", &cache.Entry{ETag: `"1"`})
+
+		// When the same key is stored again
+		c.Set("/a", &cache.Entry{ETag: `"2"`})
+
+		// Then the new entry replaces the old one
+		entry, ok := c.Get("/a")
+		require.True(t, ok)
+		require.Equal(t, `"2"`, entry.ETag)
+		require.Equal(t, 1, c.Len())
+	})
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	t.Run("should remove the path, its queries and its sub-paths only", func(t *testing.T) {
+		// Given entries below and next to a resource path
+		c := NewCache(10)
+		for _, key := range []string{
+			"/servers/cs-1?api-version=1.0",
+			"/servers/cs-1",
+			"/servers/cs-1/metrics?api-version=1.0",
+			"/servers/cs-10?api-version=1.0",
+			"/servers?api-version=1.0",
+		} {
+			c.Set(key, &cache.Entry{ETag: `"x"`})
+		}
+
+		// When the resource path is invalidated
+		c.Invalidate("/servers/cs-1/")
+
+		// Then only the entries of the resource and below are removed
+		for _, key := range []string{"/servers/cs-1?api-version=1.0", "/servers/cs-1", "/servers/cs-1/metrics?api-version=1.0"} {
+			_, ok := c.Get(key)
+			require.False(t, ok, key)
+		}
+		for _, key := range []string{"/servers/cs-10?api-version=1.0", "/servers?api-version=1.0"} {
+			_, ok := c.Get(key)
+			require.True(t, ok, key)
+		}
+	})
+}
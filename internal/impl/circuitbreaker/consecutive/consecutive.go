@@ -0,0 +1,161 @@
+// Package consecutive provides an implementation of the circuitbreaker.Breaker
+// tripping a circuit after a number of consecutive failures.
+//
+// Every circuit goes through three states:
+//   - closed: requests flow; consecutive failures are counted and the circuit
+//     opens once they reach the threshold.
+//   - open: requests are rejected until the cool-down expires.
+//   - half-open: a limited number of probe requests are let through. The
+//     circuit closes once as many probes succeed, and opens again as soon as
+//     one of them fails.
+package consecutive
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+)
+
+// Breaker is a thread-safe circuit breaker keeping one circuit per key.
+type Breaker struct {
+	failureThreshold int
+	coolDown         time.Duration
+	halfOpenProbes   int
+
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+
+	locker   sync.Mutex
+	circuits map[string]*circuit
+}
+
+var _ circuitbreaker.Breaker = (*Breaker)(nil)
+
+type state int
+
+const (
+	stateClosed state = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// circuit is the state of a single key. It is guarded by the Breaker locker.
+type circuit struct {
+	state state
+
+	// failures counts the consecutive failures while closed.
+	failures int
+
+	// openedAt is the time the circuit last opened.
+	openedAt time.Time
+
+	// probes counts the probe requests in flight while half-open, successes
+	// the probes which succeeded.
+	probes    int
+	successes int
+
+	// generation is incremented at every state change, so the outcomes of
+	// requests sent in a previous state are ignored.
+	generation uint64
+}
+
+// NewBreaker creates a breaker opening a circuit after failureThreshold
+// consecutive failures and keeping it open for coolDown. Afterwards up to
+// halfOpenProbes probe requests are let through at the same time, and the
+// circuit closes once as many of them succeed.
+// Non-positive thresholds and probes default to 1.
+func NewBreaker(failureThreshold int, coolDown time.Duration, halfOpenProbes int) *Breaker {
+	return &Breaker{
+		failureThreshold: max(failureThreshold, 1),
+		coolDown:         coolDown,
+		halfOpenProbes:   max(halfOpenProbes, 1),
+		now:              time.Now,
+		circuits:         make(map[string]*circuit),
+	}
+}
+
+// Allow implements circuitbreaker.Breaker.
+func (b *Breaker) Allow(key string) (func(failure bool), error) {
+	b.locker.Lock()
+	defer b.locker.Unlock()
+
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{}
+		b.circuits[key] = c
+	}
+
+	if c.state == stateOpen {
+		elapsed := b.now().Sub(c.openedAt)
+		if elapsed < b.coolDown {
+			return nil, &circuitbreaker.OpenError{Key: key, RetryAfter: b.coolDown - elapsed}
+		}
+
+		c.setState(stateHalfOpen)
+	}
+
+	if c.state == stateHalfOpen {
+		if c.probes >= b.halfOpenProbes {
+			return nil, &circuitbreaker.OpenError{Key: key}
+		}
+		c.probes++
+	}
+
+	generation := c.generation
+	var once sync.Once
+
+	return func(failure bool) {
+		once.Do(func() { b.done(c, generation, failure) })
+	}, nil
+}
+
+// done records the outcome of a request sent while the circuit was in the
+// given generation.
+func (b *Breaker) done(c *circuit, generation uint64, failure bool) {
+	b.locker.Lock()
+	defer b.locker.Unlock()
+
+	if c.generation != generation {
+		return
+	}
+
+	switch c.state {
+	case stateClosed:
+		if !failure {
+			c.failures = 0
+			return
+		}
+
+		c.failures++
+		if c.failures >= b.failureThreshold {
+			b.open(c)
+		}
+
+	case stateHalfOpen:
+		if failure {
+			b.open(c)
+			return
+		}
+
+		c.probes--
+		c.successes++
+		if c.successes >= b.halfOpenProbes {
+			c.setState(stateClosed)
+		}
+	}
+}
+
+func (b *Breaker) open(c *circuit) {
+	c.setState(stateOpen)
+	c.openedAt = b.now()
+}
+
+// setState moves the circuit to a new state, resetting its counters.
+func (c *circuit) setState(s state) {
+	c.state = s
+	c.failures = 0
+	c.probes = 0
+	c.successes = 0
+	c.generation++
+}
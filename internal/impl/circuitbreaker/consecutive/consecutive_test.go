@@ -0,0 +1,129 @@
+package consecutive
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestBreaker(failureThreshold int, coolDown time.Duration, halfOpenProbes int) (*Breaker, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	b := NewBreaker(failureThreshold, coolDown, halfOpenProbes)
+	b.now = clock.Now
+	return b, clock
+}
+
+func send(t *testing.T, b *Breaker, key string, failure bool) {
+	t.Helper()
+	done, err := b.Allow(key)
+	require.NoError(t, err)
+	done(failure)
+}
+
+func TestBreaker_Allow(t *testing.T) {
+	t.Run("should open after consecutive failures only", func(t *testing.T) {
+		// Given a breaker opening after 3 consecutive failures
+		b, _ := newTestBreaker(3, time.Minute, 1)
+
+		// When failures are interleaved with a success
+		send(t, b, "providers/Aruba.Compute", true)
+		send(t, b, "providers/Aruba.Compute", true)
+		send(t, b, "providers/Aruba.Compute", false)
+		send(t, b, "providers/Aruba.Compute", true)
+		send(t, b, "providers/Aruba.Compute", true)
+
+		// Then the circuit is still closed
+		send(t, b, "providers/Aruba.Compute", true)
+
+		// And it opens at the third consecutive failure
+		_, err := b.Allow("providers/Aruba.Compute")
+		require.ErrorIs(t, err, circuitbreaker.ErrCircuitOpen)
+
+		var openErr *circuitbreaker.OpenError
+		require.True(t, errors.As(err, &openErr))
+		require.Equal(t, "providers/Aruba.Compute", openErr.Key)
+		require.Equal(t, time.Minute, openErr.RetryAfter)
+	})
+
+	t.Run("should keep the circuits of different keys apart", func(t *testing.T) {
+		// Given a breaker whose database circuit is open
+		b, _ := newTestBreaker(1, time.Minute, 1)
+		send(t, b, "providers/Aruba.Database", true)
+
+		// When a request is sent to another provider
+		// Then it is allowed
+		send(t, b, "providers/Aruba.Compute", false)
+
+		_, err := b.Allow("providers/Aruba.Database")
+		require.ErrorIs(t, err, circuitbreaker.ErrCircuitOpen)
+	})
+
+	t.Run("should close after successful probes", func(t *testing.T) {
+		// Given an open circuit allowing 2 probes
+		b, clock := newTestBreaker(1, time.Minute, 2)
+		send(t, b, "k", true)
+
+		// When the cool-down expires
+		clock.Advance(time.Minute)
+
+		// Then only 2 probes are let through at the same time
+		done1, err := b.Allow("k")
+		require.NoError(t, err)
+		done2, err := b.Allow("k")
+		require.NoError(t, err)
+		_, err = b.Allow("k")
+		require.ErrorIs(t, err, circuitbreaker.ErrCircuitOpen)
+
+		// And the circuit closes once both succeed
+		done1(false)
+		done2(false)
+		for range 3 {
+			send(t, b, "k", false)
+		}
+	})
+
+	t.Run("should reopen when a probe fails", func(t *testing.T) {
+		// Given a half-open circuit
+		b, clock := newTestBreaker(1, time.Minute, 1)
+		send(t, b, "k", true)
+		clock.Advance(time.Minute)
+
+		// When the probe fails
+		send(t, b, "k", true)
+
+		// Then the circuit is open for a whole new cool-down
+		clock.Advance(30 * time.Second)
+		_, err := b.Allow("k")
+
+		var openErr *circuitbreaker.OpenError
+		require.ErrorAs(t, err, &openErr)
+		require.Equal(t, 30*time.Second, openErr.RetryAfter)
+	})
+
+	t.Run("should ignore outcomes of requests sent before a state change", func(t *testing.T) {
+		// Given a request in flight while the circuit opens
+		b, clock := newTestBreaker(1, time.Minute, 1)
+		slow, err := b.Allow("k")
+		require.NoError(t, err)
+		send(t, b, "k", true)
+
+		// When the cool-down expires and the slow request eventually fails
+		clock.Advance(time.Minute)
+		slow(true)
+
+		// Then the circuit still lets a probe through
+		send(t, b, "k", false)
+	})
+}
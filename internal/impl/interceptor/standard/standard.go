@@ -1,5 +1,6 @@
 // Package standard provides a concrete, default implementation of the
-// `interceptor.Interceptor` and `interceptor.Interceptable` interfaces.
+// `interceptor.Interceptor`, `interceptor.RoundTripper` and
+// `interceptor.MiddlewareInterceptable` interfaces.
 package standard
 
 import (
@@ -11,16 +12,23 @@ import (
 )
 
 // Interceptor is the concrete type that holds and executes a chain of
-// interceptor.InterceptFunc functions.
+// interceptor.InterceptFunc and interceptor.MiddlewareFunc functions.
 //
-// interceptFuncs is expected to be frozen after construction. See Bind for the
-// concurrency contract.
+// interceptFuncs and chain are expected to be frozen after construction. See
+// Bind for the concurrency contract.
 type Interceptor struct {
+	// interceptFuncs holds the request-phase functions, run by Intercept.
 	interceptFuncs []interceptor.InterceptFunc
+
+	// chain holds every bound function in binding order, intercept functions
+	// adapted as middleware. It is run by RoundTrip.
+	chain []interceptor.MiddlewareFunc
 }
 
 var _ interceptor.Interceptable = (*Interceptor)(nil)
+var _ interceptor.MiddlewareInterceptable = (*Interceptor)(nil)
 var _ interceptor.Interceptor = (*Interceptor)(nil)
+var _ interceptor.RoundTripper = (*Interceptor)(nil)
 
 // NewInterceptor creates and returns a pointer to a new, empty Interceptor
 // instance.
@@ -39,7 +47,10 @@ func NewInterceptorWithFuncs(interceptFuncs ...interceptor.InterceptFunc) (*Inte
 		return nil, fmt.Errorf("%w: %w", interceptor.ErrInvalidInterceptFunc, err)
 	}
 
-	return &Interceptor{interceptFuncs: interceptFuncs}, nil
+	i := &Interceptor{}
+	i.bind(interceptFuncs...)
+
+	return i, nil
 }
 
 // Bind implements interceptor.Interceptable. It is intended for
@@ -49,11 +60,37 @@ func (i *Interceptor) Bind(interceptFuncs ...interceptor.InterceptFunc) error {
 		return err
 	}
 
-	i.interceptFuncs = append(i.interceptFuncs, interceptFuncs...)
+	i.bind(interceptFuncs...)
+
+	return nil
+}
+
+// BindMiddleware implements interceptor.MiddlewareInterceptable. The same
+// concurrency contract of Bind applies.
+//
+// Middleware functions only run when the interceptor is driven via
+// RoundTrip.
+func (i *Interceptor) BindMiddleware(middlewareFuncs ...interceptor.MiddlewareFunc) error {
+	for _, middlewareFunc := range middlewareFuncs {
+		if middlewareFunc == nil {
+			return fmt.Errorf("%w: nil middleware function are not allowed to be bound", interceptor.ErrInvalidMiddlewareFunc)
+		}
+	}
+
+	i.chain = append(i.chain, middlewareFuncs...)
 
 	return nil
 }
 
+// bind appends already validated intercept functions to both chains.
+func (i *Interceptor) bind(interceptFuncs ...interceptor.InterceptFunc) {
+	i.interceptFuncs = append(i.interceptFuncs, interceptFuncs...)
+
+	for _, interceptFunc := range interceptFuncs {
+		i.chain = append(i.chain, asMiddlewareFunc(interceptFunc))
+	}
+}
+
 // Intercept implements interceptor.Interceptor. Concurrent calls to Intercept
 // are safe as long as Bind is not called concurrently.
 func (i *Interceptor) Intercept(ctx context.Context, r *http.Request) error {
@@ -70,6 +107,38 @@ func (i *Interceptor) Intercept(ctx context.Context, r *http.Request) error {
 	return nil
 }
 
+// RoundTrip implements interceptor.RoundTripper. Bound functions run in
+// binding order around next: the first bound is the outermost, so the last
+// bound one is the closest to the wire. Concurrent calls to RoundTrip are
+// safe as long as Bind is not called concurrently.
+func (i *Interceptor) RoundTrip(ctx context.Context, r *http.Request, next interceptor.RoundTripFunc) (*http.Response, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w: nil http requests are not allowed to be intercepted", interceptor.ErrInvalidHTTPRequest)
+	}
+
+	for idx := len(i.chain) - 1; idx >= 0; idx-- {
+		middlewareFunc, inner := i.chain[idx], next
+		next = func(ctx context.Context, r *http.Request) (*http.Response, error) {
+			return middlewareFunc(ctx, r, inner)
+		}
+	}
+
+	return next(ctx, r)
+}
+
+// asMiddlewareFunc adapts a request-phase function to the round-trip chain.
+// Errors are wrapped as in Intercept, so callers can tell a failed request
+// preparation from a failed exchange.
+func asMiddlewareFunc(interceptFunc interceptor.InterceptFunc) interceptor.MiddlewareFunc {
+	return func(ctx context.Context, r *http.Request, next interceptor.RoundTripFunc) (*http.Response, error) {
+		if err := interceptFunc(ctx, r); err != nil {
+			return nil, fmt.Errorf("%w: %w", interceptor.ErrInterceptFuncFailed, err)
+		}
+
+		return next(ctx, r)
+	}
+}
+
 // validateInterceptFuncs is an unexported helper function that checks if any of
 // the provided intercept functions are nil.
 func validateInterceptFuncs(interceptFuncs ...interceptor.InterceptFunc) error {
@@ -121,7 +121,138 @@ func TestInterceptor_Intercept(t *testing.T) {
 	})
 }
 
+func TestInterceptor_BindMiddleware(t *testing.T) {
+	t.Run("should refuse nil middleware functions", func(t *testing.T) {
+		// Given a fresh standard interceptor
+		instance := NewInterceptor()
+
+		// When we try to bind a nil middleware function
+		err := instance.BindMiddleware(nil)
+
+		// Then an invalid middleware function error is reported
+		require.ErrorIs(t, err, interceptor.ErrInvalidMiddlewareFunc)
+
+		// And the chain should be empty
+		require.Empty(t, instance.chain)
+	})
+}
+
+func TestInterceptor_RoundTrip(t *testing.T) {
+	t.Run("should fail to round-trip nil http requests", func(t *testing.T) {
+		// Given a fresh standard interceptor
+		instance := NewInterceptor()
+
+		// When we try to round-trip a nil http request
+		_, err := instance.RoundTrip(t.Context(), nil, createSender(t, nil))
+
+		// Then an invalid http request error is reported
+		require.ErrorIs(t, err, interceptor.ErrInvalidHTTPRequest)
+	})
+
+	t.Run("should run intercept and middleware functions in binding order", func(t *testing.T) {
+		// Given a standard interceptor mixing intercept and middleware functions
+		var tracer strings.Builder
+		instance := NewInterceptor()
+		require.NoError(t, instance.Bind(createValidInterceptFuncsWithTracer(t, &tracer)[0]))
+		require.NoError(t, instance.BindMiddleware(createTracingMiddlewareFunc(t, &tracer, "mw_1")))
+		require.NoError(t, instance.Bind(createValidInterceptFuncsWithTracer(t, &tracer)[2]))
+
+		// And a fresh valid http request
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+
+		// When we round-trip the request
+		resp, err := instance.RoundTrip(t.Context(), r, createSender(t, &tracer))
+
+		// Then no error should be reported
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// And the functions wrap the exchange in binding order
+		require.Equal(t, "func_0>mw_1func_2[send]<mw_1", tracer.String())
+	})
+
+	t.Run("should let middleware functions rewrite the response", func(t *testing.T) {
+		// Given a standard interceptor with a middleware replacing 401 responses
+		instance := NewInterceptor()
+		require.NoError(t, instance.BindMiddleware(
+			func(ctx context.Context, r *http.Request, next interceptor.RoundTripFunc) (*http.Response, error) {
+				resp, err := next(ctx, r)
+				if err != nil {
+					return nil, err
+				}
+				resp.StatusCode = http.StatusTeapot
+				return resp, nil
+			},
+		))
+
+		// And a fresh valid http request
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+
+		// When we round-trip the request
+		resp, err := instance.RoundTrip(t.Context(), r, createSender(t, nil))
+
+		// Then the rewritten response is returned
+		require.NoError(t, err)
+		require.Equal(t, http.StatusTeapot, resp.StatusCode)
+	})
+
+	t.Run("should stop before sending when one of intercept functions fail", func(t *testing.T) {
+		// Given a standard interceptor with a set of valid intercept functions which we know that one will fail
+		instance, _ := NewInterceptorWithFuncs(createValidInterceptFuncsWithErrors(t)...)
+
+		// And a fresh valid http request
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+
+		// When we round-trip the request
+		var tracer strings.Builder
+		_, err := instance.RoundTrip(t.Context(), r, createSender(t, &tracer))
+
+		// Then an intercept function failure error is reported
+		require.ErrorIs(t, err, interceptor.ErrInterceptFuncFailed)
+
+		// And the request is never sent
+		require.Empty(t, tracer.String())
+	})
+
+	t.Run("should not run middleware functions via Intercept", func(t *testing.T) {
+		// Given a standard interceptor with a middleware function only
+		var tracer strings.Builder
+		instance := NewInterceptor()
+		require.NoError(t, instance.BindMiddleware(createTracingMiddlewareFunc(t, &tracer, "mw")))
+
+		// When we intercept a request
+		r, _ := http.NewRequest(http.MethodGet, "https://www.aruba.it/", nil)
+		err := instance.Intercept(t.Context(), r)
+
+		// Then nothing runs
+		require.NoError(t, err)
+		require.Empty(t, tracer.String())
+	})
+}
+
 // Helpers
+func createSender(t *testing.T, tracer io.Writer) interceptor.RoundTripFunc {
+	t.Helper()
+
+	return func(ctx context.Context, r *http.Request) (*http.Response, error) {
+		if tracer != nil {
+			fmt.Fprintf(tracer, "[send]")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}
+}
+
+func createTracingMiddlewareFunc(t *testing.T, tracer io.Writer, name string) interceptor.MiddlewareFunc {
+	t.Helper()
+
+	return func(ctx context.Context, r *http.Request, next interceptor.RoundTripFunc) (*http.Response, error) {
+		fmt.Fprintf(tracer, ">%s", name)
+		resp, err := next(ctx, r)
+		fmt.Fprintf(tracer, "<%s", name)
+		return resp, err
+	}
+}
+
 func createValidInterceptFuncs(t *testing.T) []interceptor.InterceptFunc {
 	t.Helper()
 
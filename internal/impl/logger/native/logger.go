@@ -1,14 +1,15 @@
 package native
 
 import (
+	"context"
 	"log"
 	"os"
 
 	"github.com/Arubacloud/sdk-go/internal/ports/logger"
 )
 
-// Compile-time assertion that *DefaultLogger satisfies logger.Logger.
-var _ logger.Logger = (*DefaultLogger)(nil)
+// Compile-time assertion that *DefaultLogger satisfies logger.StructuredLogger.
+var _ logger.StructuredLogger = (*DefaultLogger)(nil)
 
 // DefaultLogger is a simple logger implementation using standard log package
 type DefaultLogger struct {
@@ -43,3 +44,24 @@ func (l *DefaultLogger) Warnf(format string, args ...interface{}) {
 func (l *DefaultLogger) Errorf(format string, args ...interface{}) {
 	l.err.Printf(format, args...)
 }
+
+// Enabled always returns true: the default logger emits every level.
+func (l *DefaultLogger) Enabled(_ context.Context, _ logger.Level) bool {
+	return true
+}
+
+// Log prints the message followed by the fields as key=value pairs.
+func (l *DefaultLogger) Log(_ context.Context, level logger.Level, msg string, fields ...logger.Field) {
+	line := logger.Format(msg, fields...)
+
+	switch level {
+	case logger.LevelDebug:
+		l.debug.Print(line)
+	case logger.LevelInfo:
+		l.info.Print(line)
+	case logger.LevelWarn:
+		l.warn.Print(line)
+	default:
+		l.err.Print(line)
+	}
+}
@@ -1,9 +1,13 @@
 package noop
 
-import "github.com/Arubacloud/sdk-go/internal/ports/logger"
+import (
+	"context"
 
-// Compile-time assertion that *NoOpLogger satisfies logger.Logger.
-var _ logger.Logger = (*NoOpLogger)(nil)
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+)
+
+// Compile-time assertion that *NoOpLogger satisfies logger.StructuredLogger.
+var _ logger.StructuredLogger = (*NoOpLogger)(nil)
 
 // NoOpLogger is a logger that does nothing
 type NoOpLogger struct{}
@@ -12,3 +16,7 @@ func (l *NoOpLogger) Debugf(format string, args ...interface{}) {}
 func (l *NoOpLogger) Infof(format string, args ...interface{})  {}
 func (l *NoOpLogger) Warnf(format string, args ...interface{})  {}
 func (l *NoOpLogger) Errorf(format string, args ...interface{}) {}
+
+func (l *NoOpLogger) Enabled(ctx context.Context, level logger.Level) bool { return false }
+func (l *NoOpLogger) Log(ctx context.Context, level logger.Level, msg string, fields ...logger.Field) {
+}
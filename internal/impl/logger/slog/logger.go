@@ -0,0 +1,106 @@
+// Package slog provides a logger.StructuredLogger backed by a log/slog
+// handler.
+package slog
+
+import (
+	"context"
+	"fmt"
+	stdslog "log/slog"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+)
+
+// Compile-time assertion that *Logger satisfies logger.StructuredLogger.
+var _ logger.StructuredLogger = (*Logger)(nil)
+
+// Logger emits SDK log records through a slog.Handler. Fields become slog
+// attributes; printf-style records are formatted into the message.
+type Logger struct {
+	logger *stdslog.Logger
+}
+
+// NewLogger creates a Logger writing to the given handler. A nil handler
+// falls back to the handler of slog.Default.
+func NewLogger(handler stdslog.Handler) *Logger {
+	if handler == nil {
+		handler = stdslog.Default().Handler()
+	}
+
+	return &Logger{logger: stdslog.New(handler)}
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.logf(logger.LevelDebug, format, args...)
+}
+
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.logf(logger.LevelInfo, format, args...)
+}
+
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	l.logf(logger.LevelWarn, format, args...)
+}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.logf(logger.LevelError, format, args...)
+}
+
+// Enabled reports whether the handler accepts records of the given level.
+func (l *Logger) Enabled(ctx context.Context, level logger.Level) bool {
+	return l.logger.Enabled(ctx, toSlogLevel(level))
+}
+
+// Log emits a record with the fields as slog attributes.
+func (l *Logger) Log(ctx context.Context, level logger.Level, msg string, fields ...logger.Field) {
+	slogLevel := toSlogLevel(level)
+	if !l.logger.Enabled(ctx, slogLevel) {
+		return
+	}
+
+	attrs := make([]stdslog.Attr, 0, len(fields))
+	for _, f := range fields {
+		attrs = append(attrs, toAttr(f))
+	}
+
+	l.logger.LogAttrs(ctx, slogLevel, msg, attrs...)
+}
+
+// logf formats the message only when the level is enabled, so disabled debug
+// records cost nothing.
+func (l *Logger) logf(level logger.Level, format string, args ...interface{}) {
+	ctx := context.Background()
+	slogLevel := toSlogLevel(level)
+	if !l.logger.Enabled(ctx, slogLevel) {
+		return
+	}
+
+	l.logger.Log(ctx, slogLevel, fmt.Sprintf(format, args...))
+}
+
+func toSlogLevel(level logger.Level) stdslog.Level {
+	switch level {
+	case logger.LevelDebug:
+		return stdslog.LevelDebug
+	case logger.LevelInfo:
+		return stdslog.LevelInfo
+	case logger.LevelWarn:
+		return stdslog.LevelWarn
+	default:
+		return stdslog.LevelError
+	}
+}
+
+// toAttr converts a field into a slog attribute. Errors are rendered as
+// their message, since most handlers would otherwise print them as empty
+// objects.
+func toAttr(f logger.Field) stdslog.Attr {
+	switch v := f.Value.(type) {
+	case error:
+		return stdslog.String(f.Key, v.Error())
+	case time.Duration:
+		return stdslog.Duration(f.Key, v)
+	default:
+		return stdslog.Any(f.Key, v)
+	}
+}
@@ -0,0 +1,81 @@
+package slog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	stdslog "log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+)
+
+func TestLogger_Log(t *testing.T) {
+	t.Run("should emit the fields as attributes", func(t *testing.T) {
+		//
+		// Given a logger writing JSON records
+		var buf bytes.Buffer
+		l := NewLogger(stdslog.NewJSONHandler(&buf, &stdslog.HandlerOptions{Level: stdslog.LevelDebug}))
+
+		//
+		// When a structured record is logged
+		l.Log(context.Background(), logger.LevelWarn, "attempt failed",
+			logger.F(logger.KeyMethod, "GET"),
+			logger.F(logger.KeyStatus, 503),
+			logger.F(logger.KeyDuration, 1500*time.Millisecond),
+			logger.F(logger.KeyError, errors.New("boom")),
+		)
+
+		//
+		// Then the record carries every field
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "WARN", record["level"])
+		require.Equal(t, "attempt failed", record["msg"])
+		require.Equal(t, "GET", record[logger.KeyMethod])
+		require.EqualValues(t, 503, record[logger.KeyStatus])
+		require.EqualValues(t, 1500*time.Millisecond, record[logger.KeyDuration])
+		require.Equal(t, "boom", record[logger.KeyError])
+	})
+
+	t.Run("should honour the handler level", func(t *testing.T) {
+		//
+		// Given a logger discarding debug records
+		var buf bytes.Buffer
+		l := NewLogger(stdslog.NewTextHandler(&buf, &stdslog.HandlerOptions{Level: stdslog.LevelInfo}))
+
+		//
+		// When debug records are logged
+		l.Log(context.Background(), logger.LevelDebug, "hidden")
+		l.Debugf("hidden %d", 1)
+
+		//
+		// Then nothing is written
+		require.False(t, l.Enabled(context.Background(), logger.LevelDebug))
+		require.Empty(t, buf.String())
+	})
+}
+
+func TestLogger_Printf(t *testing.T) {
+	t.Run("should format the message", func(t *testing.T) {
+		//
+		// Given a logger writing JSON records
+		var buf bytes.Buffer
+		l := NewLogger(stdslog.NewJSONHandler(&buf, nil))
+
+		//
+		// When a printf-style record is logged
+		l.Errorf("request failed: %v", "timeout")
+
+		//
+		// Then the formatted message is written with the right level
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "ERROR", record["level"])
+		require.Equal(t, "request failed: timeout", record["msg"])
+	})
+}
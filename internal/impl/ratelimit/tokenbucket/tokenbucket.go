@@ -0,0 +1,181 @@
+// Package tokenbucket provides a token-bucket implementation of the
+// ratelimit.Limiter.
+// Read requests (GET, HEAD, OPTIONS) and mutating requests draw from two
+// independent buckets, so a burst of creations cannot starve polling and vice
+// versa. An optional semaphore caps the number of requests in flight.
+package tokenbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/ratelimit"
+)
+
+// Limiter is a thread-safe token-bucket rate limiter.
+type Limiter struct {
+	// read and write are the buckets for read and mutating requests.
+	// A nil bucket means the corresponding class is not throttled.
+	read  *bucket
+	write *bucket
+
+	// inFlight is a counting semaphore limiting concurrent requests.
+	// Nil means no limit.
+	inFlight chan struct{}
+}
+
+var _ ratelimit.Limiter = (*Limiter)(nil)
+
+// NewLimiter creates a limiter allowing readsPerSecond read requests and
+// writesPerSecond mutating requests on average, with bursts up to readBurst
+// and writeBurst respectively. At most maxInFlight requests are allowed to
+// be in flight at the same time.
+// A non-positive rate disables throttling of the corresponding class, and a
+// non-positive maxInFlight disables the concurrency cap. Non-positive bursts
+// default to 1.
+func NewLimiter(readsPerSecond float64, readBurst int, writesPerSecond float64, writeBurst int, maxInFlight int) *Limiter {
+	l := &Limiter{
+		read:  newBucket(readsPerSecond, readBurst),
+		write: newBucket(writesPerSecond, writeBurst),
+	}
+
+	if maxInFlight > 0 {
+		l.inFlight = make(chan struct{}, maxInFlight)
+	}
+
+	return l
+}
+
+// Acquire implements ratelimit.Limiter.
+func (l *Limiter) Acquire(ctx context.Context, method string) (func(), error) {
+	b := l.write
+	if isRead(method) {
+		b = l.read
+	}
+
+	if b != nil {
+		if err := b.wait(ctx); err != nil {
+			return nil, fmt.Errorf("%w: %w", ratelimit.ErrRateLimitWaitFailed, err)
+		}
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: %w", ratelimit.ErrRateLimitWaitFailed, ctx.Err())
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() { <-l.inFlight })
+	}, nil
+}
+
+func isRead(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+//
+// Token Bucket
+
+// bucket is a token bucket refilled continuously at a fixed rate.
+// Tokens may go negative: each waiter reserves its token up-front and sleeps
+// for the time needed to pay the debt back, which keeps waiters fair without
+// any queue.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	locker sync.Mutex
+
+	// now returns the current time. It is a field so tests can control it.
+	now func() time.Time
+}
+
+func newBucket(perSecond float64, burst int) *bucket {
+	if perSecond <= 0 {
+		return nil
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &bucket{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// using it.
+func (b *bucket) reserve() time.Duration {
+	b.locker.Lock()
+	defer b.locker.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a waiter that gave up.
+func (b *bucket) cancel() {
+	b.locker.Lock()
+	defer b.locker.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *bucket) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,129 @@
+package tokenbucket
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/ratelimit"
+)
+
+func TestBucket_Reserve(t *testing.T) {
+	t.Run("should allow the burst and then pace requests", func(t *testing.T) {
+		// Given a bucket of 2 tokens refilled at 10 tokens per second
+		now := time.Unix(0, 0)
+		b := newBucket(10, 2)
+		b.now = func() time.Time { return now }
+
+		// When three reservations are made at the same instant
+		first, second, third := b.reserve(), b.reserve(), b.reserve()
+
+		// Then the burst is served immediately
+		require.Zero(t, first)
+		require.Zero(t, second)
+
+		// And the third reservation waits for one token to be refilled
+		require.Equal(t, 100*time.Millisecond, third)
+
+		// And time passing refills the bucket
+		now = now.Add(300 * time.Millisecond)
+		require.Zero(t, b.reserve())
+	})
+
+	t.Run("should not refill beyond the burst", func(t *testing.T) {
+		now := time.Unix(0, 0)
+		b := newBucket(100, 1)
+		b.now = func() time.Time { return now }
+
+		require.Zero(t, b.reserve())
+		now = now.Add(time.Hour)
+		require.Zero(t, b.reserve())
+		require.Equal(t, 10*time.Millisecond, b.reserve())
+	})
+
+	t.Run("should be disabled by a non-positive rate", func(t *testing.T) {
+		require.Nil(t, newBucket(0, 10))
+	})
+}
+
+func TestLimiter_Acquire(t *testing.T) {
+	t.Run("should keep read and write budgets separated", func(t *testing.T) {
+		// Given a limiter with a single token per class and a very slow refill
+		l := NewLimiter(0.001, 1, 0.001, 1, 0)
+
+		// When a read and a write are acquired
+		releaseRead, err := l.Acquire(context.Background(), http.MethodGet)
+		require.NoError(t, err)
+		releaseRead()
+
+		releaseWrite, err := l.Acquire(context.Background(), http.MethodPost)
+		require.NoError(t, err)
+		releaseWrite()
+
+		// Then a further write has to wait and gives up with the context
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = l.Acquire(ctx, http.MethodDelete)
+		require.ErrorIs(t, err, ratelimit.ErrRateLimitWaitFailed)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("should not throttle unlimited classes", func(t *testing.T) {
+		l := NewLimiter(0, 0, 0.001, 1, 0)
+
+		for i := 0; i < 100; i++ {
+			release, err := l.Acquire(context.Background(), http.MethodGet)
+			require.NoError(t, err)
+			release()
+		}
+	})
+
+	t.Run("should cap the in-flight requests", func(t *testing.T) {
+		// Given a limiter allowing two requests in flight
+		l := NewLimiter(0, 0, 0, 0, 2)
+
+		first, err := l.Acquire(context.Background(), http.MethodGet)
+		require.NoError(t, err)
+		_, err = l.Acquire(context.Background(), http.MethodPut)
+		require.NoError(t, err)
+
+		// When a third request is attempted
+		acquired := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := l.Acquire(context.Background(), http.MethodGet)
+			require.NoError(t, err)
+			close(acquired)
+			release()
+		}()
+
+		// Then it waits until a slot is released
+		select {
+		case <-acquired:
+			t.Fatal("third request should wait for a free slot")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		first()
+		first() // releasing twice must not free two slots
+
+		wg.Wait()
+		require.Len(t, l.inFlight, 1)
+	})
+
+	t.Run("should fail fast on a done context", func(t *testing.T) {
+		l := NewLimiter(1, 1, 1, 1, 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := l.Acquire(ctx, http.MethodGet)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
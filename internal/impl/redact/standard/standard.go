@@ -0,0 +1,227 @@
+// Package standard provides the default implementation of redact.Redactor,
+// driven by JSON field paths scoped to URL path patterns.
+package standard
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/redact"
+)
+
+// Compile-time assertion that *Redactor satisfies redact.Redactor.
+var _ redact.Redactor = (*Redactor)(nil)
+
+// Rule lists the sensitive JSON fields of the bodies exchanged with the URL
+// paths matching Path.
+//
+// Fields are dot-separated JSON paths (e.g. "properties.identity.clientSecret").
+// Arrays are traversed transparently, so a path applies to every element of
+// the arrays met along the way (e.g. "values.password" covers every item of a
+// list). A "*" segment matches any key.
+type Rule struct {
+	// Path matches the URL paths the rule applies to. A nil Path applies the
+	// rule to every body.
+	Path *regexp.Regexp
+
+	// Fields are the JSON paths of the values to mask.
+	Fields []string
+
+	// Opaque marks bodies which are sensitive as a whole: they are masked
+	// entirely when they are not JSON.
+	Opaque bool
+}
+
+// DefaultRules returns the rules covering the sensitive fields of the Aruba
+// Cloud API: cloud server passwords, KaaS identity client secrets, database
+// user passwords, VPN tunnel pre-shared keys, kubeconfig downloads and KMIP
+// certificates.
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			// types.CloudServerPasswordRequest
+			Path:   regexp.MustCompile(`/providers/Aruba\.Compute/cloudServers/[^/]+/password$`),
+			Fields: []string{"password"},
+			Opaque: true,
+		},
+		{
+			// types.KaaSIdentityPropertiesRequest
+			Path:   regexp.MustCompile(`/providers/Aruba\.Container/kaas(/[^/]+)?$`),
+			Fields: []string{"properties.identity.clientSecret", "values.properties.identity.clientSecret"},
+		},
+		{
+			// types.KaaSKubeconfigResponse
+			Path:   regexp.MustCompile(`/providers/Aruba\.Container/kaas/[^/]+/download$`),
+			Fields: []string{"content"},
+			Opaque: true,
+		},
+		{
+			// types.UserRequest
+			Path:   regexp.MustCompile(`/providers/Aruba\.Database/dbaas/[^/]+/users(/[^/]+)?$`),
+			Fields: []string{"password", "values.password"},
+		},
+		{
+			// types.PSKSettingsCommon
+			Path:   regexp.MustCompile(`/providers/Aruba\.Network/vpnTunnels(/[^/]+)?$`),
+			Fields: []string{"properties.vpnClientSettings.psk.secret", "values.properties.vpnClientSettings.psk.secret"},
+		},
+		{
+			// types.KmipCertificateResponse
+			Path:   regexp.MustCompile(`/providers/Aruba\.Security/kms/[^/]+/kmip/[^/]+/download$`),
+			Fields: []string{"key", "cert"},
+			Opaque: true,
+		},
+	}
+}
+
+// DefaultHeaders returns the headers carrying credentials.
+func DefaultHeaders() []string {
+	return []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+}
+
+// Redactor is the standard implementation of redact.Redactor.
+type Redactor struct {
+	rules   []rule
+	headers map[string]bool
+}
+
+// rule is a Rule with its field paths split into segments.
+type rule struct {
+	path   *regexp.Regexp
+	fields [][]string
+	opaque bool
+}
+
+// NewRedactor creates a Redactor masking the fields described by the rules
+// and the values of the given headers, matched case-insensitively.
+func NewRedactor(rules []Rule, headers []string) *Redactor {
+	r := &Redactor{headers: make(map[string]bool, len(headers))}
+
+	for _, h := range headers {
+		r.headers[http.CanonicalHeaderKey(h)] = true
+	}
+
+	for _, rl := range rules {
+		compiled := rule{path: rl.Path, opaque: rl.Opaque}
+		for _, field := range rl.Fields {
+			if field = strings.TrimSpace(field); field != "" {
+				compiled.fields = append(compiled.fields, strings.Split(field, "."))
+			}
+		}
+		r.rules = append(r.rules, compiled)
+	}
+
+	return r
+}
+
+// RedactHeaders implements redact.Redactor.
+func (r *Redactor) RedactHeaders(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+
+	for key, values := range header {
+		if !r.headers[http.CanonicalHeaderKey(key)] {
+			redacted[key] = values
+			continue
+		}
+
+		masked := make([]string, len(values))
+		for i, value := range values {
+			masked[i] = maskHeaderValue(value)
+		}
+		redacted[key] = masked
+	}
+
+	return redacted
+}
+
+// maskHeaderValue masks a header value, keeping the authentication scheme
+// (e.g. "Bearer") when there is one.
+func maskHeaderValue(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok && !strings.ContainsAny(scheme, "=;") {
+		return scheme + " " + redact.Mask
+	}
+
+	return redact.Mask
+}
+
+// RedactBody implements redact.Redactor.
+func (r *Redactor) RedactBody(path string, body []byte) []byte {
+	var fields [][]string
+	opaque := false
+
+	for _, rl := range r.rules {
+		if rl.path != nil && !rl.path.MatchString(path) {
+			continue
+		}
+		fields = append(fields, rl.fields...)
+		opaque = opaque || rl.opaque
+	}
+
+	if len(fields) == 0 && !opaque || len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		if opaque {
+			return []byte(redact.Mask)
+		}
+		return body
+	}
+
+	masked := false
+	for _, field := range fields {
+		masked = maskPath(document, field) || masked
+	}
+
+	if !masked {
+		return body
+	}
+
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return []byte(redact.Mask)
+	}
+
+	return redacted
+}
+
+// maskPath replaces the values found at the given path with Mask, reporting
+// whether any value was masked.
+func maskPath(node any, path []string) bool {
+	switch n := node.(type) {
+	case []any:
+		masked := false
+		for _, item := range n {
+			masked = maskPath(item, path) || masked
+		}
+		return masked
+
+	case map[string]any:
+		masked := false
+		for key, value := range n {
+			if path[0] != "*" && path[0] != key {
+				continue
+			}
+
+			if len(path) == 1 {
+				if value != nil {
+					n[key] = redact.Mask
+					masked = true
+				}
+				continue
+			}
+
+			masked = maskPath(value, path[1:]) || masked
+		}
+		return masked
+	}
+
+	return false
+}
@@ -0,0 +1,210 @@
+// Package backoff provides an exponential backoff implementation of the
+// retry.Policy.
+// Delays grow exponentially from a base value up to a ceiling and are
+// randomised with "full jitter", so that concurrent clients hitting the same
+// transient failure do not retry in lockstep. A Retry-After header sent by the
+// server always takes precedence over the computed delay.
+package backoff
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
+)
+
+// Policy is a stateless, thread-safe exponential backoff retry policy.
+type Policy struct {
+	// maxAttempts is the total number of attempts allowed, the first one
+	// included. Values lower than 2 disable retries.
+	maxAttempts int
+
+	// baseDelay is the upper bound of the delay before the second attempt.
+	// The bound doubles after every subsequent attempt.
+	baseDelay time.Duration
+
+	// maxDelay caps the computed delay. A Retry-After value greater than
+	// maxDelay makes the policy give up instead of waiting that long.
+	maxDelay time.Duration
+
+	// retryNonIdempotent allows POST and PATCH requests to be retried.
+	retryNonIdempotent bool
+
+	// jitter returns a pseudo-random number in [0.0, 1.0). It is a field so
+	// tests can make delays deterministic.
+	jitter func() float64
+}
+
+var _ retry.Policy = (*Policy)(nil)
+
+const (
+	stdMaxAttempts = 4
+	stdBaseDelay   = 500 * time.Millisecond
+	stdMaxDelay    = 30 * time.Second
+)
+
+// NewPolicy creates an exponential backoff policy allowing up to maxAttempts
+// attempts per request (the first one included). Only idempotent requests,
+// and requests carrying an idempotency key, are retried.
+func NewPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) *Policy {
+	return &Policy{
+		maxAttempts: maxAttempts,
+		baseDelay:   baseDelay,
+		maxDelay:    maxDelay,
+		jitter:      rand.Float64,
+	}
+}
+
+// NewPolicyWithNonIdempotentRetries creates an exponential backoff policy that
+// also retries non-idempotent requests (POST, PATCH).
+// Use it only when the server is known to deduplicate repeated requests.
+func NewPolicyWithNonIdempotentRetries(maxAttempts int, baseDelay, maxDelay time.Duration) *Policy {
+	p := NewPolicy(maxAttempts, baseDelay, maxDelay)
+	p.retryNonIdempotent = true
+	return p
+}
+
+// NewStandardPolicy creates a policy with the SDK defaults: 4 attempts, a
+// 500ms base delay and a 30s ceiling.
+func NewStandardPolicy() *Policy {
+	return NewPolicy(stdMaxAttempts, stdBaseDelay, stdMaxDelay)
+}
+
+// ShouldRetry implements retry.Policy.
+func (p *Policy) ShouldRetry(ctx context.Context, attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.maxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	if req != nil && !p.retryNonIdempotent && !IsIdempotent(req.Method) && req.Header.Get(retry.IdempotencyKeyHeader) == "" {
+		return 0, false
+	}
+
+	if err != nil {
+		if !IsTransientError(err) {
+			return 0, false
+		}
+		return p.delay(attempt), true
+	}
+
+	if resp == nil || !IsRetryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	if retryAfter, ok := ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		if p.maxDelay > 0 && retryAfter > p.maxDelay {
+			return 0, false
+		}
+		return retryAfter, true
+	}
+
+	return p.delay(attempt), true
+}
+
+// delay computes the full-jitter delay before the attempt following the
+// given one.
+func (p *Policy) delay(attempt int) time.Duration {
+	ceiling := p.baseDelay
+	for i := 1; i < attempt && (p.maxDelay <= 0 || ceiling < p.maxDelay); i++ {
+		ceiling *= 2
+	}
+
+	if p.maxDelay > 0 && ceiling > p.maxDelay {
+		ceiling = p.maxDelay
+	}
+
+	jitter := p.jitter
+	if jitter == nil {
+		jitter = rand.Float64
+	}
+
+	return time.Duration(jitter() * float64(ceiling))
+}
+
+//
+// Classification Helpers
+
+// IsIdempotent reports whether requests with the given method can be safely
+// repeated, as defined by RFC 9110.
+func IsIdempotent(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// IsRetryableStatus reports whether the status code denotes a transient
+// server-side condition: throttling or an unavailable upstream.
+func IsRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// IsTransientError reports whether a transport error is likely to go away on
+// its own, e.g. a connection reset or a dial timeout. Context cancellation is
+// never considered transient.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// ParseRetryAfter parses a Retry-After header value, expressed either in
+// delay-seconds or as an HTTP-date, relative to now.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		d := date.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
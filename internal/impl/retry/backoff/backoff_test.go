@@ -0,0 +1,209 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
+)
+
+func newDeterministicPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) *Policy {
+	p := NewPolicy(maxAttempts, baseDelay, maxDelay)
+	p.jitter = func() float64 { return 1 }
+	return p
+}
+
+func newResponse(statusCode int, retryAfter string) *http.Response {
+	resp := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+	if retryAfter != "" {
+		resp.Header.Set("Retry-After", retryAfter)
+	}
+	return resp
+}
+
+func newRequest(t *testing.T, method string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://api.example.com/resource", nil)
+	require.NoError(t, err)
+	return req
+}
+
+func TestPolicy_ShouldRetry(t *testing.T) {
+	t.Run("should retry retryable statuses with exponential delays", func(t *testing.T) {
+		// Given a deterministic policy allowing 4 attempts
+		policy := newDeterministicPolicy(4, 100*time.Millisecond, time.Second)
+		req := newRequest(t, http.MethodGet)
+
+		// When the server keeps answering 503
+		var delays []time.Duration
+		for attempt := 1; attempt <= 3; attempt++ {
+			delay, retry := policy.ShouldRetry(context.Background(), attempt, req, newResponse(http.StatusServiceUnavailable, ""), nil)
+			require.True(t, retry)
+			delays = append(delays, delay)
+		}
+
+		// Then the delay doubles after every attempt
+		require.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}, delays)
+
+		// And the policy gives up once the attempts are exhausted
+		_, retry := policy.ShouldRetry(context.Background(), 4, req, newResponse(http.StatusServiceUnavailable, ""), nil)
+		require.False(t, retry)
+	})
+
+	t.Run("should cap the delay to the maximum", func(t *testing.T) {
+		policy := newDeterministicPolicy(10, time.Second, 3*time.Second)
+
+		delay, retry := policy.ShouldRetry(context.Background(), 5, newRequest(t, http.MethodGet), newResponse(http.StatusBadGateway, ""), nil)
+
+		require.True(t, retry)
+		require.Equal(t, 3*time.Second, delay)
+	})
+
+	t.Run("should apply jitter below the ceiling", func(t *testing.T) {
+		policy := NewPolicy(4, time.Second, 10*time.Second)
+		policy.jitter = func() float64 { return 0.25 }
+
+		delay, retry := policy.ShouldRetry(context.Background(), 2, newRequest(t, http.MethodGet), newResponse(http.StatusGatewayTimeout, ""), nil)
+
+		require.True(t, retry)
+		require.Equal(t, 500*time.Millisecond, delay)
+	})
+
+	t.Run("should honour the Retry-After header", func(t *testing.T) {
+		policy := newDeterministicPolicy(4, 100*time.Millisecond, time.Minute)
+
+		delay, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, http.MethodGet), newResponse(http.StatusTooManyRequests, "7"), nil)
+
+		require.True(t, retry)
+		require.Equal(t, 7*time.Second, delay)
+	})
+
+	t.Run("should give up when Retry-After exceeds the maximum delay", func(t *testing.T) {
+		policy := newDeterministicPolicy(4, 100*time.Millisecond, time.Second)
+
+		_, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, http.MethodGet), newResponse(http.StatusTooManyRequests, "120"), nil)
+
+		require.False(t, retry)
+	})
+
+	t.Run("should not retry non-retryable statuses", func(t *testing.T) {
+		policy := NewStandardPolicy()
+
+		for _, status := range []int{http.StatusOK, http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError} {
+			_, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, http.MethodGet), newResponse(status, ""), nil)
+			require.False(t, retry, "status %d", status)
+		}
+	})
+
+	t.Run("should not retry non-idempotent methods by default", func(t *testing.T) {
+		policy := NewStandardPolicy()
+
+		for _, method := range []string{http.MethodPost, http.MethodPatch} {
+			_, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, method), newResponse(http.StatusServiceUnavailable, ""), nil)
+			require.False(t, retry, "method %s", method)
+		}
+	})
+
+	t.Run("should retry non-idempotent methods when allowed", func(t *testing.T) {
+		policy := NewPolicyWithNonIdempotentRetries(3, time.Millisecond, time.Second)
+
+		_, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, http.MethodPost), newResponse(http.StatusServiceUnavailable, ""), nil)
+
+		require.True(t, retry)
+	})
+
+	t.Run("should retry non-idempotent methods carrying an idempotency key", func(t *testing.T) {
+		policy := NewStandardPolicy()
+		req := newRequest(t, http.MethodPost)
+		req.Header.Set(retry.IdempotencyKeyHeader, "key-1")
+
+		_, shouldRetry := policy.ShouldRetry(context.Background(), 1, req, newResponse(http.StatusServiceUnavailable, ""), nil)
+
+		require.True(t, shouldRetry)
+	})
+
+	t.Run("should retry transient transport errors", func(t *testing.T) {
+		policy := NewStandardPolicy()
+		err := fmt.Errorf("read: %w", syscall.ECONNRESET)
+
+		_, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, http.MethodGet), nil, err)
+
+		require.True(t, retry)
+	})
+
+	t.Run("should not retry unknown errors", func(t *testing.T) {
+		policy := NewStandardPolicy()
+
+		_, retry := policy.ShouldRetry(context.Background(), 1, newRequest(t, http.MethodGet), nil, errors.New("boom"))
+
+		require.False(t, retry)
+	})
+
+	t.Run("should stop when the context is cancelled", func(t *testing.T) {
+		policy := NewStandardPolicy()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, retry := policy.ShouldRetry(ctx, 1, newRequest(t, http.MethodGet), newResponse(http.StatusServiceUnavailable, ""), nil)
+
+		require.False(t, retry)
+	})
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "context canceled", err: context.Canceled, want: false},
+		{name: "deadline exceeded", err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded), want: false},
+		{name: "connection reset", err: syscall.ECONNRESET, want: true},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, want: true},
+		{name: "generic", err: errors.New("generic"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsTransientError(tt.err))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should parse delay-seconds", func(t *testing.T) {
+		d, ok := ParseRetryAfter("3", now)
+		require.True(t, ok)
+		require.Equal(t, 3*time.Second, d)
+	})
+
+	t.Run("should parse HTTP-dates", func(t *testing.T) {
+		d, ok := ParseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now)
+		require.True(t, ok)
+		require.Equal(t, 90*time.Second, d)
+	})
+
+	t.Run("should clamp dates in the past", func(t *testing.T) {
+		d, ok := ParseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+		require.True(t, ok)
+		require.Zero(t, d)
+	})
+
+	t.Run("should reject invalid values", func(t *testing.T) {
+		for _, v := range []string{"", "-1", "soon"} {
+			_, ok := ParseRetryAfter(v, now)
+			require.False(t, ok, "value %q", v)
+		}
+	})
+}
@@ -25,6 +25,8 @@ var (
 type TokenManager interface {
 	// BindTo registers the TokenManager's injection logic (InjectToken) with
 	// a request interceptor. This allows the manager to act as middleware.
+	// Implementations may bind a round-trip middleware instead, when the
+	// interceptor supports it, to also react to rejected tokens.
 	BindTo(interceptable interceptor.Interceptable) error
 
 	// InjectToken is an interceptor function that retrieves a valid token
@@ -43,6 +45,15 @@ type TokenRepository interface {
 	SaveToken(ctx context.Context, token *Token) error
 }
 
+// TokenInvalidator is implemented by the token repositories able to discard
+// the stored token, e.g. once it has been rejected by the API although it
+// had not expired yet.
+type TokenInvalidator interface {
+	// InvalidateToken discards the stored token, so that FetchToken returns
+	// ErrTokenNotFound until a new token is saved.
+	InvalidateToken(ctx context.Context) error
+}
+
 // ProviderConnector defines the contract for communicating with the external
 // identity provider (IdP). Its sole responsibility is fetching a *fresh* token.
 type ProviderConnector interface {
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"crypto"
 	"time"
 )
 
@@ -14,6 +15,10 @@ type Token struct {
 	// Expiry is the optional expiration time of the access token.
 	// If zero, the token is assumed to never expire (or expiration is not tracked).
 	Expiry time.Time `json:"expiry,omitempty"`
+
+	// RefreshToken is the optional token used to obtain a new access token
+	// without involving the user again, issued by the interactive grants.
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
 
 // IsValid checks if the token is usable.
@@ -35,8 +40,9 @@ func (t *Token) IsValid() bool {
 // Copy creates a copy of the Token and returns its reference.
 func (t *Token) Copy() *Token {
 	return &Token{
-		AccessToken: t.AccessToken,
-		Expiry:      t.Expiry,
+		AccessToken:  t.AccessToken,
+		Expiry:       t.Expiry,
+		RefreshToken: t.RefreshToken,
 	}
 }
 
@@ -47,12 +53,28 @@ type Credentials struct {
 
 	// ClientSecret is the application's private secret.
 	ClientSecret string `json:"client_secret"`
+
+	// PrivateKey is the optional PEM-encoded RSA or ECDSA private key signing
+	// the client assertions (RFC 7523) used instead of the ClientSecret.
+	PrivateKey string `json:"private_key,omitempty"`
+
+	// Signer optionally signs the client assertions in place of PrivateKey,
+	// e.g. with a key held by an HSM or a KMS. It is never serialized.
+	Signer crypto.Signer `json:"-"`
+
+	// KeyID optionally identifies the signing key to the IdP, as the "kid"
+	// header of the client assertions.
+	KeyID string `json:"key_id,omitempty"`
 }
 
 // Copy creates a copy of the Credentials and returns its reference.
+// The Signer is shared.
 func (c *Credentials) Copy() *Credentials {
 	return &Credentials{
 		ClientID:     c.ClientID,
 		ClientSecret: c.ClientSecret,
+		PrivateKey:   c.PrivateKey,
+		Signer:       c.Signer,
+		KeyID:        c.KeyID,
 	}
 }
@@ -0,0 +1,38 @@
+// Package cache provides the basic interface used to store GET responses
+// along with their validators (ETag, Last-Modified), so that they can be
+// revalidated with conditional requests and served again when the API
+// replies 304 Not Modified.
+package cache
+
+import "net/http"
+
+// Entry is a cached response.
+type Entry struct {
+	// ETag and LastModified are the validators sent back in the
+	// If-None-Match and If-Modified-Since headers. At least one is set.
+	ETag         string
+	LastModified string
+
+	// StatusCode, Header and Body describe the cached response.
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Cache stores entries keyed by request URI (path and encoded query, e.g.
+// "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1?api-version=1.0").
+//
+// Implementations must be safe for concurrent use, since a single cache is
+// shared by every request issued through a client. Entries must be treated
+// as immutable by both the cache and its callers.
+type Cache interface {
+	// Get returns the entry stored for key, if any.
+	Get(key string) (*Entry, bool)
+
+	// Set stores an entry for key, replacing the previous one.
+	Set(key string, entry *Entry)
+
+	// Invalidate removes the entries of the given path, whatever their
+	// query, and of every path below it.
+	Invalidate(path string)
+}
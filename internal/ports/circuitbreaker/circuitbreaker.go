@@ -0,0 +1,51 @@
+// Package circuitbreaker provides the basic interface used to stop sending
+// requests to a degraded service provider for a while, so that callers fail
+// fast instead of piling up on it.
+package circuitbreaker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+)
+
+// OpenError is returned when a request is rejected because the circuit of
+// its provider is open. It wraps ErrCircuitOpen.
+type OpenError struct {
+	// Key identifies the circuit, e.g. "providers/Aruba.Compute".
+	Key string
+
+	// RetryAfter is the time left before the circuit lets a probe request
+	// through. It is zero when the circuit is half-open and all the probe
+	// slots are taken.
+	RetryAfter time.Duration
+}
+
+func (e *OpenError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: %s (retry after %s)", ErrCircuitOpen, e.Key, e.RetryAfter)
+	}
+
+	return fmt.Sprintf("%s: %s", ErrCircuitOpen, e.Key)
+}
+
+func (e *OpenError) Unwrap() error { return ErrCircuitOpen }
+
+// Breaker tracks the health of a set of circuits, each identified by a key,
+// and decides whether a request may be sent.
+//
+// Implementations must be safe for concurrent use, since a single breaker is
+// shared by every request issued through a client.
+type Breaker interface {
+	// Allow is called before every attempt sent to the circuit identified by
+	// key. When the request may be sent, it returns a done function that
+	// must be called exactly once with the outcome of the attempt: failure
+	// is true when the attempt failed in a way denoting a degraded provider
+	// (e.g. a 5xx status or a connection failure). When the circuit is open,
+	// it returns an *OpenError and nothing needs to be done.
+	Allow(key string) (done func(failure bool), err error)
+}
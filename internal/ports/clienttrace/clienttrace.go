@@ -0,0 +1,127 @@
+// Package clienttrace provides a set of hooks, in the style of
+// net/http/httptrace, called at the relevant points of the life of a client:
+// HTTP attempts, retries, token acquisition, wait-loop ticks and pagination.
+// They are meant to feed metrics and other observability tools.
+package clienttrace
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+// ClientTrace is a set of hooks. Any particular hook may be nil.
+//
+// Hooks are called synchronously by the goroutine issuing the request, so
+// they must return quickly; they may be called concurrently by different
+// goroutines and must be safe for concurrent use.
+type ClientTrace struct {
+	// RequestStart is called right before an attempt is handed to the
+	// middleware and sent.
+	RequestStart func(ctx context.Context, info RequestStartInfo)
+
+	// RequestDone is called when an attempt is over, whatever its outcome.
+	RequestDone func(ctx context.Context, info RequestDoneInfo)
+
+	// Retry is called when a failed attempt is going to be re-sent, before
+	// waiting for the retry delay.
+	Retry func(ctx context.Context, info RetryInfo)
+
+	// TokenObtained is called every time an access token is needed to
+	// authenticate a request.
+	TokenObtained func(ctx context.Context, info TokenInfo)
+
+	// WaitTick is called after every polling tick of a wait helper (e.g.
+	// WaitUntilStates, WaitUntilGone).
+	WaitTick func(ctx context.Context, info WaitTickInfo)
+
+	// PageFetched is called after a page of a paginated list is fetched by
+	// following a pagination link.
+	PageFetched func(ctx context.Context, info PageInfo)
+}
+
+// RequestStartInfo describes an attempt about to be sent.
+type RequestStartInfo struct {
+	Method string
+	URL    string
+
+	// Attempt is the 1-based number of the attempt.
+	Attempt int
+}
+
+// RequestDoneInfo describes the outcome of an attempt. Exactly one of
+// StatusCode and Err is set.
+type RequestDoneInfo struct {
+	Method     string
+	URL        string
+	Attempt    int
+	StatusCode int
+	Err        error
+	Latency    time.Duration
+}
+
+// RetryInfo describes an attempt that is going to be re-sent.
+type RetryInfo struct {
+	Method string
+	URL    string
+
+	// Attempt is the 1-based number of the failed attempt.
+	Attempt int
+
+	// StatusCode is the status of the failed attempt, or zero when it failed
+	// with a transport error.
+	StatusCode int
+	Err        error
+
+	// Delay is the time waited before the next attempt.
+	Delay time.Duration
+}
+
+// TokenSource tells where an access token came from.
+type TokenSource string
+
+const (
+	// TokenFromRepository denotes a valid token read from the token
+	// repository, including one just saved by a concurrent refresh.
+	TokenFromRepository TokenSource = "repository"
+
+	// TokenRefreshed denotes a token freshly requested to the provider
+	// connector.
+	TokenRefreshed TokenSource = "refreshed"
+)
+
+// TokenInfo describes how the access token of a request was obtained.
+type TokenInfo struct {
+	Source TokenSource
+
+	// Err is set when the token could not be obtained.
+	Err     error
+	Latency time.Duration
+}
+
+// WaitTickInfo describes a polling tick of a wait helper.
+type WaitTickInfo struct {
+	// Attempt is the 1-based number of the tick.
+	Attempt int
+
+	// State is the lifecycle state observed by the tick, or the zero State
+	// for resources without one and for failed ticks.
+	State types.State
+
+	// Targets are the states the helper is waiting for; nil for
+	// WaitUntilGone.
+	Targets []types.State
+
+	// Gone reports whether WaitUntilGone observed the resource deletion.
+	Gone bool
+	Err  error
+}
+
+// PageInfo describes the fetch of a page of a paginated list.
+type PageInfo struct {
+	URL        string
+	StatusCode int
+	Err        error
+	Latency    time.Duration
+}
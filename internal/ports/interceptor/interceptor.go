@@ -1,6 +1,14 @@
 // Package interceptor provides basic interfaces and types for implementing
 // request interception logic, typically used before send or processing an
 // HTTP request.
+//
+// Two kinds of interception are supported:
+//   - InterceptFunc, which only sees the outgoing request;
+//   - MiddlewareFunc, which wraps the whole exchange and can therefore also
+//     inspect, rewrite or react to the response.
+//
+// Both kinds can be bound to the same chain, which runs them in binding
+// order: the first bound function is the outermost one.
 package interceptor
 
 import (
@@ -13,6 +21,8 @@ var (
 	ErrInvalidInterceptFunc = errors.New("invalid intercept function")
 	ErrInvalidHTTPRequest   = errors.New("invalid http request")
 	ErrInterceptFuncFailed  = errors.New("intercept function failed")
+
+	ErrInvalidMiddlewareFunc = errors.New("invalid middleware function")
 )
 
 // InterceptFunc is a function signature that defines the core interception
@@ -24,6 +34,28 @@ var (
 // the request should be halted.
 type InterceptFunc func(ctx context.Context, r *http.Request) error
 
+// RoundTripFunc is a function signature that performs an HTTP exchange: it
+// sends the *http.Request and returns the received *http.Response.
+type RoundTripFunc func(ctx context.Context, r *http.Request) (*http.Response, error)
+
+// MiddlewareFunc is a function signature that defines a round-trip
+// interception logic, wrapping the whole HTTP exchange.
+//
+// It receives a context.Context, the *http.Request and the next step of the
+// chain. Implementations may modify the request before calling next, inspect
+// or replace the response it returns, call it again (e.g. to replay the
+// request) or not call it at all to short-circuit the exchange.
+//
+// A response returned by next has a body that can be fully read and replaced
+// by the implementation; the body of a discarded response must be closed.
+type MiddlewareFunc func(ctx context.Context, r *http.Request, next RoundTripFunc) (*http.Response, error)
+
+// RoundTrip implements RoundTripper, so that a plain MiddlewareFunc can be
+// used wherever a RoundTripper is expected.
+func (f MiddlewareFunc) RoundTrip(ctx context.Context, r *http.Request, next RoundTripFunc) (*http.Response, error) {
+	return f(ctx, r, next)
+}
+
 // Interceptable is an interface implemented by components that want to have
 // one or more InterceptFuncs bound to them, usually for execution before a
 // core operation.
@@ -41,6 +73,19 @@ type Interceptable interface {
 	Bind(interceptFuncs ...InterceptFunc) error
 }
 
+// MiddlewareInterceptable is an interface implemented by components that also
+// accept MiddlewareFuncs in their execution chain.
+type MiddlewareInterceptable interface {
+	Interceptable
+
+	// BindMiddleware adds the provided MiddlewareFuncs to the component's
+	// execution chain, after any function already bound with Bind or
+	// BindMiddleware.
+	//
+	// The same concurrency contract of Interceptable.Bind applies.
+	BindMiddleware(middlewareFuncs ...MiddlewareFunc) error
+}
+
 // Interceptor is the core interface for executing the request interception
 // logic.
 //
@@ -53,3 +98,15 @@ type Interceptor interface {
 	// It returns an error immediately upon the first InterceptFunc that fails.
 	Intercept(ctx context.Context, request *http.Request) error
 }
+
+// RoundTripper is the interface for executing a round-trip interception
+// logic around an HTTP exchange.
+//
+// Components implementing both Interceptor and RoundTripper are driven via
+// RoundTrip: the HTTP client is expected to call either Intercept or
+// RoundTrip for a given request, never both.
+type RoundTripper interface {
+	// RoundTrip runs the bound interception logic around next, which performs
+	// the actual exchange, and returns the resulting response.
+	RoundTrip(ctx context.Context, request *http.Request, next RoundTripFunc) (*http.Response, error)
+}
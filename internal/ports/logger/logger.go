@@ -1,5 +1,11 @@
 package logger
 
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
 // Logger is the interface for logging within the SDK
 type Logger interface {
 	// Debugf logs a debug message with formatting
@@ -11,3 +17,126 @@ type Logger interface {
 	// Errorf logs an error message with formatting
 	Errorf(format string, args ...interface{})
 }
+
+// Level is the severity of a structured log record.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the upper-case name of the level (e.g. "DEBUG").
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	}
+
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+// Field keys used by the SDK for structured log records.
+const (
+	KeyMethod     = "method"
+	KeyPath       = "path"
+	KeyStatus     = "status"
+	KeyDuration   = "duration"
+	KeyProject    = "project"
+	KeyResourceID = "resource_id"
+	KeyAttempt    = "attempt"
+	KeyError      = "error"
+	KeyRequestID  = "request_id"
+)
+
+// Field is a key/value pair attached to a structured log record.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// F builds a Field.
+func F(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+// StructuredLogger is a Logger which also accepts records made of a message
+// and key/value fields.
+type StructuredLogger interface {
+	Logger
+
+	// Enabled reports whether records of the given level are emitted, so
+	// callers can skip building expensive fields.
+	Enabled(ctx context.Context, level Level) bool
+
+	// Log emits a record with the given level, message and fields.
+	Log(ctx context.Context, level Level, msg string, fields ...Field)
+}
+
+// Structured returns l itself when it is already a StructuredLogger, and a
+// shim rendering the fields as key=value pairs through the printf methods
+// otherwise. A nil logger yields a logger discarding every record.
+func Structured(l Logger) StructuredLogger {
+	if s, ok := l.(StructuredLogger); ok {
+		return s
+	}
+
+	return printfShim{l}
+}
+
+// printfShim adapts a printf-style Logger to StructuredLogger.
+type printfShim struct {
+	Logger
+}
+
+func (s printfShim) Enabled(_ context.Context, _ Level) bool {
+	return s.Logger != nil
+}
+
+func (s printfShim) Log(_ context.Context, level Level, msg string, fields ...Field) {
+	if s.Logger == nil {
+		return
+	}
+
+	line := Format(msg, fields...)
+
+	switch level {
+	case LevelDebug:
+		s.Debugf("%s", line)
+	case LevelInfo:
+		s.Infof("%s", line)
+	case LevelWarn:
+		s.Warnf("%s", line)
+	default:
+		s.Errorf("%s", line)
+	}
+}
+
+// Format renders a record as the message followed by space-separated
+// key=value pairs. Values containing spaces or quotes are quoted.
+func Format(msg string, fields ...Field) string {
+	var b strings.Builder
+	b.WriteString(msg)
+
+	for _, f := range fields {
+		value := fmt.Sprint(f.Value)
+		if value == "" || strings.ContainsAny(value, " \"=") {
+			value = fmt.Sprintf("%q", value)
+		}
+
+		b.WriteByte(' ')
+		b.WriteString(f.Key)
+		b.WriteByte('=')
+		b.WriteString(value)
+	}
+
+	return b.String()
+}
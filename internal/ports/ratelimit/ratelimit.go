@@ -0,0 +1,27 @@
+// Package ratelimit provides the basic interface used to throttle the
+// requests issued by a client before they reach the network.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrRateLimitWaitFailed = errors.New("rate limit wait failed")
+)
+
+// Limiter gates outbound requests.
+//
+// Implementations must be safe for concurrent use, since a single limiter may
+// be shared by several clients.
+type Limiter interface {
+	// Acquire blocks until a request with the given HTTP method is allowed to
+	// be sent, or until the context is done.
+	//
+	// On success it returns a release function that must be called exactly
+	// once, when the exchange is over, to free any in-flight slot held by the
+	// request. On failure the returned error wraps ErrRateLimitWaitFailed and
+	// nothing needs to be released.
+	Acquire(ctx context.Context, method string) (release func(), err error)
+}
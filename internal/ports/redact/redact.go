@@ -0,0 +1,26 @@
+// Package redact provides the basic interface used to mask sensitive data
+// (passwords, secrets, certificates, credentials) before requests and
+// responses are logged.
+package redact
+
+import "net/http"
+
+// Mask is the placeholder replacing every redacted value.
+const Mask = "[REDACTED]"
+
+// Redactor masks sensitive data out of what is about to be logged. It never
+// alters what is sent to or received from the server.
+//
+// Implementations must be safe for concurrent use, since a single redactor is
+// shared by every request issued through a client.
+type Redactor interface {
+	// RedactHeaders returns a copy of the header with the values of sensitive
+	// headers replaced by Mask.
+	RedactHeaders(header http.Header) http.Header
+
+	// RedactBody returns a copy of the request or response body exchanged
+	// with the given URL path, with the values of sensitive JSON fields
+	// replaced by Mask. Bodies which are known to be sensitive but cannot be
+	// parsed are masked as a whole.
+	RedactBody(path string, body []byte) []byte
+}
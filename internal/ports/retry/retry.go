@@ -0,0 +1,31 @@
+// Package retry provides the basic interface used to decide whether a failed
+// HTTP exchange should be attempted again, and how long to wait before doing
+// so.
+package retry
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// IdempotencyKeyHeader carries the key identifying a mutating request, so
+// that the API applies it at most once however many times it is sent.
+// Requests carrying it can be safely retried whatever their method.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// Policy decides whether a request should be re-sent after an attempt.
+//
+// Implementations must be safe for concurrent use, since a single policy is
+// shared by every request issued through a client.
+type Policy interface {
+	// ShouldRetry is called after every attempt. The attempt argument is the
+	// 1-based number of attempts already performed. Exactly one of resp and
+	// err is non-nil: resp is the response received from the server, err is
+	// the transport error returned by the HTTP client.
+	//
+	// It returns the delay to wait before the next attempt and whether a new
+	// attempt should be made at all. Implementations should not read the
+	// response body.
+	ShouldRetry(ctx context.Context, attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+}
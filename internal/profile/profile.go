@@ -0,0 +1,188 @@
+// Package profile reads the named configurations of the profiles file
+// (~/.aruba/config), shared by the tools using the SDK so that each of them
+// does not need its own settings.
+//
+// The file is made of INI-style sections, one per profile:
+//
+//	[default]
+//	base_url = https://api.arubacloud.com
+//	token_issuer_url = https://login.example.com/token
+//	scopes = read write
+//	client_id = my-client
+//	client_secret = my-secret
+//
+// Lines starting with '#' or ';' are comments. Every key is optional.
+package profile
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultName is the profile used when none is given, nor set by the
+	// NameVariable environment variable.
+	DefaultName = "default"
+
+	// NameVariable is the environment variable selecting the profile when
+	// none is given.
+	NameVariable = "ARUBA_PROFILE"
+
+	// FileVariable is the environment variable overriding the path of the
+	// profiles file.
+	FileVariable = "ARUBA_CONFIG_FILE"
+)
+
+var (
+	// ErrFileNotFound reports that the profiles file does not exist.
+	ErrFileNotFound = errors.New("profiles file not found")
+
+	// ErrProfileNotFound reports that the profiles file has no section for
+	// the profile.
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+// Profile is a named configuration of the profiles file.
+type Profile struct {
+	// Name is the name of the profile.
+	Name string
+
+	// BaseURL is the Aruba Cloud API URL.
+	BaseURL string
+
+	// TokenIssuerURL is the OAuth2 token endpoint URL.
+	TokenIssuerURL string
+
+	// Scopes are the security scopes to be claimed, separated by spaces or
+	// commas in the file.
+	Scopes []string
+
+	// ClientID and ClientSecret are the OAuth2 client credentials.
+	ClientID     string
+	ClientSecret string
+}
+
+// DefaultPath returns the path of the profiles file: the value of the
+// FileVariable environment variable, or .aruba/config in the home directory
+// of the user.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(FileVariable); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the profiles file: %w", err)
+	}
+
+	return filepath.Join(home, ".aruba", "config"), nil
+}
+
+// ResolveName returns name, or the profile selected by the NameVariable
+// environment variable when it is empty, or DefaultName.
+func ResolveName(name string) string {
+	if name != "" {
+		return name
+	}
+
+	if name := os.Getenv(NameVariable); name != "" {
+		return name
+	}
+
+	return DefaultName
+}
+
+// Load reads the profile name from the profiles file at path.
+func Load(path, name string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", ErrFileNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to read profiles file: %w", err)
+	}
+
+	profiles, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profiles file %s: %w", path, err)
+	}
+
+	profile, found := profiles[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %q in %s", ErrProfileNotFound, name, path)
+	}
+
+	return profile, nil
+}
+
+// parse reads all the profiles of a profiles file.
+func parse(data []byte) (map[string]*Profile, error) {
+	profiles := make(map[string]*Profile)
+
+	var current *Profile
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if name, found := strings.CutPrefix(line, "["); found {
+			name, found = strings.CutSuffix(name, "]")
+			name = strings.TrimSpace(name)
+			if !found || name == "" {
+				return nil, fmt.Errorf("line %d: malformed section", number)
+			}
+			if _, duplicate := profiles[name]; duplicate {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", number, name)
+			}
+
+			current = &Profile{Name: name}
+			profiles[name] = current
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected key = value", number)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile section", number)
+		}
+		if err := current.set(strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func (p *Profile) set(key, value string) error {
+	switch key {
+	case "base_url":
+		p.BaseURL = value
+	case "token_issuer_url":
+		p.TokenIssuerURL = value
+	case "scopes":
+		p.Scopes = strings.FieldsFunc(value, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+	case "client_id":
+		p.ClientID = value
+	case "client_secret":
+		p.ClientSecret = value
+	default:
+		return fmt.Errorf("unknown key %q", key)
+	}
+
+	return nil
+}
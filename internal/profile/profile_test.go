@@ -0,0 +1,114 @@
+package profile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeFile(t, `
+# Production
+[default]
+base_url = https://api.arubacloud.com
+token_issuer_url = https://login.example.com/token
+scopes = read, write  admin
+client_id = prod-id
+client_secret = prod=secret
+
+; Staging
+[ staging ]
+base_url = https://api.staging.example.com
+`)
+
+	got, err := Load(path, "default")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := &Profile{
+		Name:           "default",
+		BaseURL:        "https://api.arubacloud.com",
+		TokenIssuerURL: "https://login.example.com/token",
+		Scopes:         []string{"read", "write", "admin"},
+		ClientID:       "prod-id",
+		ClientSecret:   "prod=secret",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Load(default) = %+v, want %+v", got, want)
+	}
+
+	got, err = Load(path, "staging")
+	if err != nil || got.BaseURL != "https://api.staging.example.com" || got.ClientID != "" {
+		t.Errorf("Load(staging) = %+v, %v, want the staging base URL only", got, err)
+	}
+
+	if _, err := Load(path, "test"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("Load(test) error = %v, want ErrProfileNotFound", err)
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "config"), "default"); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("Load() of a missing file error = %v, want ErrFileNotFound", err)
+	}
+}
+
+func TestLoad_Malformed(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"client_id = id", "line 1: key outside of a profile section"},
+		{"[default\nclient_id = id", "line 1: malformed section"},
+		{"[default]\nclient_id", "line 2: expected key = value"},
+		{"[default]\nclient_secrt = secret", `line 2: unknown key "client_secrt"`},
+		{"[default]\n[default]", `line 2: duplicate profile "default"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Load(writeFile(t, tt.content), "default")
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Load(%q) error = %v, want %q", tt.content, err, tt.want)
+		}
+	}
+}
+
+func TestDefaultPathAndResolveName(t *testing.T) {
+	t.Setenv(FileVariable, "")
+	t.Setenv(NameVariable, "")
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory: %v", err)
+	}
+	if path, err := DefaultPath(); err != nil || path != filepath.Join(home, ".aruba", "config") {
+		t.Errorf("DefaultPath() = %q, %v, want the file in the home directory", path, err)
+	}
+	if name := ResolveName(""); name != DefaultName {
+		t.Errorf("ResolveName(\"\") = %q, want %q", name, DefaultName)
+	}
+
+	t.Setenv(FileVariable, "/etc/aruba/config")
+	t.Setenv(NameVariable, "staging")
+
+	if path, err := DefaultPath(); err != nil || path != "/etc/aruba/config" {
+		t.Errorf("DefaultPath() = %q, %v, want the file of %s", path, err, FileVariable)
+	}
+	if name := ResolveName(""); name != "staging" {
+		t.Errorf("ResolveName(\"\") = %q, want the profile of %s", name, NameVariable)
+	}
+	if name := ResolveName("test"); name != "test" {
+		t.Errorf("ResolveName(test) = %q, want test", name)
+	}
+}
@@ -0,0 +1,169 @@
+package restclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/cache"
+)
+
+// FromCacheHeader is set on the responses served from the response cache
+// after the API replied 304 Not Modified.
+const FromCacheHeader = "X-From-Cache"
+
+// WithResponseCache sets the cache storing GET responses along with their
+// ETag and Last-Modified validators. Cached responses are revalidated with
+// If-None-Match and If-Modified-Since, and served again when the API replies
+// 304 Not Modified. A nil cache disables caching, which is the default.
+func WithResponseCache(c cache.Cache) ClientOption {
+	return func(client *Client) {
+		client.cache = c
+	}
+}
+
+// IsFromCache reports whether a response was served from the response cache.
+func IsFromCache(resp *http.Response) bool {
+	return resp != nil && resp.Header.Get(FromCacheHeader) != ""
+}
+
+// conditionalRequest adds the validators of the cached response, if any, to
+// a GET request, and returns the cached entry. Requests carrying their own
+// validators are left untouched.
+func (c *Client) conditionalRequest(req *http.Request) *cache.Entry {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return nil
+	}
+
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return nil
+	}
+
+	entry, ok := c.cache.Get(req.URL.RequestURI())
+	if !ok {
+		return nil
+	}
+
+	if entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+
+	return entry
+}
+
+// cacheResponse stores a successful GET response carrying validators, or
+// replaces a 304 Not Modified reply with the cached entry it revalidated.
+func (c *Client) cacheResponse(req *http.Request, entry *cache.Entry, resp *http.Response) *http.Response {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return resp
+	}
+
+	key := req.URL.RequestURI()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && entry != nil:
+		c.logger.Debugf("Response not modified, serving cached body for %s", key)
+		discardBody(resp)
+
+		return cachedResponse(req, entry, resp)
+
+	case resp.StatusCode == http.StatusOK:
+		etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+		if etag == "" && lastModified == "" {
+			return resp
+		}
+
+		body, err := readAndRestore(resp)
+		if err != nil {
+			return resp
+		}
+
+		c.cache.Set(key, &cache.Entry{
+			ETag:         etag,
+			LastModified: lastModified,
+			StatusCode:   resp.StatusCode,
+			Header:       resp.Header.Clone(),
+			Body:         body,
+		})
+	}
+
+	return resp
+}
+
+// invalidateCache drops the cached responses a mutation may have made
+// stale: the ones of the addressed resource, of its collection and of the
+// resources below them.
+func (c *Client) invalidateCache(method, rawURL string) {
+	if c.cache == nil {
+		return
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return
+	}
+
+	c.cache.Invalidate(path.Dir(strings.TrimSuffix(u.Path, "/")))
+}
+
+// cachedResponse builds the response served in place of a 304 Not Modified
+// reply. The headers of the reply (e.g. Date) take precedence over the cached
+// ones, except for the ones describing the body.
+func cachedResponse(req *http.Request, entry *cache.Entry, notModified *http.Response) *http.Response {
+	header := entry.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	for key, values := range notModified.Header {
+		switch key {
+		case "Content-Length", "Content-Type", "Content-Encoding":
+			continue
+		}
+		header[key] = values
+	}
+
+	header.Set(FromCacheHeader, "1")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}
+}
+
+// readAndRestore reads a response body and replaces it with a reader over
+// the same bytes, so it can still be consumed by the caller.
+func readAndRestore(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
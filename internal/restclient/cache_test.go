@@ -0,0 +1,134 @@
+package restclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/cache/lru"
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+)
+
+func TestDoRequest_ResponseCache(t *testing.T) {
+	const resourcePath = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	version := 1
+	var ifNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			version++
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		etag := `"v` + strconv.Itoa(version) + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":` + strconv.Itoa(version) + `}`))
+	}))
+	t.Cleanup(server.Close)
+
+	responseCache := lru.NewCache(10)
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithResponseCache(responseCache))
+
+	get := func() (string, bool) {
+		t.Helper()
+		resp, err := client.DoRequest(context.Background(), http.MethodGet, resourcePath, nil, map[string]string{"api-version": "1.0"}, nil)
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body), IsFromCache(resp)
+	}
+
+	if body, fromCache := get(); body != `{"version":1}` || fromCache {
+		t.Errorf("first GET = %q (from cache %v), want a fresh version 1", body, fromCache)
+	}
+	if body, fromCache := get(); body != `{"version":1}` || !fromCache {
+		t.Errorf("second GET = %q (from cache %v), want the cached version 1", body, fromCache)
+	}
+
+	// A mutation of the resource drops its cached response.
+	resp, err := client.DoRequest(context.Background(), http.MethodPut, resourcePath, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest(PUT) error = %v", err)
+	}
+	resp.Body.Close()
+	if responseCache.Len() != 0 {
+		t.Errorf("cache entries after PUT = %d, want 0", responseCache.Len())
+	}
+
+	if body, fromCache := get(); body != `{"version":2}` || fromCache {
+		t.Errorf("GET after PUT = %q (from cache %v), want a fresh version 2", body, fromCache)
+	}
+
+	want := []string{"", `"v1"`, ""}
+	if len(ifNoneMatch) != len(want) {
+		t.Fatalf("If-None-Match headers = %q, want %q", ifNoneMatch, want)
+	}
+	for i := range want {
+		if ifNoneMatch[i] != want[i] {
+			t.Errorf("If-None-Match[%d] = %q, want %q", i, ifNoneMatch[i], want[i])
+		}
+	}
+}
+
+func TestDoRequest_ResponseCacheLastModified(t *testing.T) {
+	const lastModified = "Wed, 21 Oct 2026 07:28:00 GMT"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithResponseCache(lru.NewCache(10)))
+
+	for i, wantFromCache := range []bool{false, true} {
+		resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != `{"ok":true}` || IsFromCache(resp) != wantFromCache {
+			t.Errorf("GET #%d = %q (from cache %v), want from cache %v", i+1, body, IsFromCache(resp), wantFromCache)
+		}
+	}
+}
+
+func TestDoRequest_ResponseCacheKeepsCallerValidators(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithResponseCache(lru.NewCache(10)))
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, map[string]string{"If-None-Match": `"caller"`})
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified || IsFromCache(resp) {
+		t.Errorf("StatusCode = %d (from cache %v), want an untouched 304", resp.StatusCode, IsFromCache(resp))
+	}
+}
@@ -0,0 +1,66 @@
+package restclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+	"github.com/Arubacloud/sdk-go/internal/ports/ratelimit"
+)
+
+// WithCircuitBreaker sets the breaker consulted before every attempt, keyed by
+// the provider the request is addressed to (e.g. "providers/Aruba.Compute").
+// A nil breaker disables circuit breaking, which is the default.
+func WithCircuitBreaker(breaker circuitbreaker.Breaker) ClientOption {
+	return func(c *Client) {
+		c.breaker = breaker
+	}
+}
+
+// allowCircuit asks the breaker whether the request may be sent. The
+// returned function records the outcome of the attempt and must be called
+// once it is over. Requests which are not addressed to a provider are never
+// gated.
+func (c *Client) allowCircuit(ctx context.Context, req *http.Request) (func(resp *http.Response, err error), error) {
+	key := circuitKey(req.URL.Path)
+	if c.breaker == nil || key == "" {
+		return func(*http.Response, error) {}, nil
+	}
+
+	done, err := c.breaker.Allow(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(resp *http.Response, err error) {
+		done(isProviderFailure(ctx, resp, err))
+	}, nil
+}
+
+// circuitKey returns the provider path segment of a URL path, e.g.
+// "providers/Aruba.Compute", or "" when there is none.
+func circuitKey(path string) string {
+	parts := strings.Split(path, "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "providers" && parts[i+1] != "" {
+			return "providers/" + parts[i+1]
+		}
+	}
+
+	return ""
+}
+
+// isProviderFailure reports whether the outcome of an attempt denotes a
+// degraded provider: a 5xx status or a transport failure. Failures caused by
+// the caller (a cancelled context, a failing middleware, the rate limiter)
+// do not count.
+func isProviderFailure(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		var prepErr *prepareError
+		return ctx.Err() == nil && !errors.As(err, &prepErr) && !errors.Is(err, ratelimit.ErrRateLimitWaitFailed)
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError
+}
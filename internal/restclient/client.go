@@ -3,31 +3,81 @@ package restclient
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/cache"
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
 	"github.com/Arubacloud/sdk-go/internal/ports/interceptor"
 	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+	"github.com/Arubacloud/sdk-go/internal/ports/ratelimit"
+	"github.com/Arubacloud/sdk-go/internal/ports/redact"
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
 )
 
 // Client is the main SDK client that aggregates all resource providers
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	middleware interceptor.Interceptor
-	logger     logger.Logger
+	baseURL     string
+	httpClient  *http.Client
+	middleware  interceptor.Interceptor
+	logger      logger.Logger
+	retryPolicy retry.Policy
+	rateLimiter ratelimit.Limiter
+	tracer      trace.Tracer
+	clientTrace *clienttrace.ClientTrace
+	redactor    redact.Redactor
+	breaker     circuitbreaker.Breaker
+	cache       cache.Cache
+	compression *Compression
+
+	newIdempotencyKey func() string
+
+	dryRun bool
+	plan   *Plan
+}
+
+// ClientOption configures optional behaviours of the Client.
+type ClientOption func(*Client)
+
+// WithRetryPolicy sets the policy deciding whether failed requests are
+// re-sent. A nil policy disables retries, which is the default.
+func WithRetryPolicy(policy retry.Policy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter sets the limiter every attempt waits on before being sent.
+// A nil limiter disables client-side throttling, which is the default.
+func WithRateLimiter(limiter ratelimit.Limiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
 }
 
 // NewClient creates a new SDK client with the given configuration
-func NewClient(baseURL string, httpClient *http.Client, middleware interceptor.Interceptor, logger logger.Logger) *Client {
-	return &Client{
+func NewClient(baseURL string, httpClient *http.Client, middleware interceptor.Interceptor, logger logger.Logger, opts ...ClientOption) *Client {
+	c := &Client{
 		baseURL:    baseURL,
 		httpClient: httpClient,
 		logger:     logger,
 		middleware: middleware,
+		plan:       &Plan{},
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Logger returns the client logger
@@ -35,21 +85,25 @@ func (c *Client) Logger() logger.Logger {
 	return c.logger
 }
 
+// StructuredLogger returns the client logger as a logger.StructuredLogger,
+// wrapping printf-only loggers in a shim. It never returns nil: a logger
+// discarding every record is returned when the client itself is nil.
+func (c *Client) StructuredLogger() logger.StructuredLogger {
+	if c == nil {
+		return logger.Structured(nil)
+	}
+
+	return logger.Structured(c.logger)
+}
+
 // DoRequestAbs performs an HTTP request to an absolute URL (no baseURL prefix). Use this
 // for pagination links returned by the server in links.next / links.prev.
 func (c *Client) DoRequestAbs(ctx context.Context, method, absURL string, body io.Reader, queryParams map[string]string, headers map[string]string) (*http.Response, error) {
 	c.logger.Debugf("Making %s request to absolute URL %s", method, absURL)
 
-	var bodyBytes []byte
-	if body != nil {
-		var err error
-		bodyBytes, err = io.ReadAll(body)
-		if err != nil {
-			c.logger.Errorf("Failed to read request body: %v", err)
-			return nil, fmt.Errorf("failed to read request body: %w", err)
-		}
-		c.logger.Debugf("Request body: %s", string(bodyBytes))
-		body = bytes.NewReader(bodyBytes)
+	bodyBytes, err := c.readBody(body)
+	if err != nil {
+		return nil, err
 	}
 
 	// Resolve relative pagination links against the client base URL.
@@ -59,77 +113,254 @@ func (c *Client) DoRequestAbs(ctx context.Context, method, absURL string, body i
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, absURL, body)
+	return c.send(ctx, method, absURL, bodyBytes, queryParams, headers)
+}
+
+// DoRequest performs an HTTP request with automatic authentication token injection
+func (c *Client) DoRequest(ctx context.Context, method, path string, body io.Reader, queryParams map[string]string, headers map[string]string) (*http.Response, error) {
+	// Build full URL
+	url := c.baseURL + path
+
+	c.logger.Debugf("Making %s request to %s", method, url)
+
+	bodyBytes, err := c.readBody(body)
 	if err != nil {
-		c.logger.Errorf("Failed to create request: %v", err)
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
 
-	if len(queryParams) > 0 {
-		q := req.URL.Query()
-		for key, value := range queryParams {
-			q.Add(key, value)
-		}
-		req.URL.RawQuery = q.Encode()
+	return c.send(ctx, method, url, bodyBytes, queryParams, headers)
+}
+
+// readBody buffers the request body, so it can be logged and rewound on
+// every attempt. A nil reader yields a nil slice.
+func (c *Client) readBody(body io.Reader) ([]byte, error) {
+	if body == nil {
+		return nil, nil
 	}
 
-	for k, v := range headers {
-		req.Header.Set(k, v)
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		c.logger.Errorf("Failed to read request body: %v", err)
+		return nil, fmt.Errorf("failed to read request body: %w", err)
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	return bodyBytes, nil
+}
+
+// send executes the request, re-sending it as long as the retry policy allows
+// and the circuit breaker lets it through. Every attempt is built from scratch,
+// so the body is rewound and the middleware (e.g. token injection) runs again.
+// Each attempt is traced in its own span. GET requests are revalidated against
+// the response cache, which mutations invalidate. In dry-run mode, mutations
+// are captured into the plan instead of being sent. The timeout and retry
+// policy carried by the context, if any, override those of the client.
+func (c *Client) send(ctx context.Context, method, url string, body []byte, queryParams map[string]string, headers map[string]string) (*http.Response, error) {
+	ctx, cancel := withTimeout(ctx)
+	resp, err := c.sendAttempts(ctx, method, url, body, queryParams, headers)
+	return releaseOnClose(resp, cancel), err
+}
+
+// sendAttempts runs the attempts of a request sent by send.
+func (c *Client) sendAttempts(ctx context.Context, method, url string, body []byte, queryParams map[string]string, headers map[string]string) (*http.Response, error) {
+	headers = c.withIdempotencyKey(method, headers)
+	retryPolicy := c.retryPolicyFor(ctx)
+
+	if c.isDryRun(ctx, method) {
+		return nil, c.capture(ctx, method, url, body, queryParams, headers)
+	}
+
+	defer c.invalidateCache(method, url)
+
+	for attempt := 1; ; attempt++ {
+		attemptCtx, span := c.startAttempt(ctx, method, url, attempt)
+
+		req, err := c.newRequest(attemptCtx, method, url, body, queryParams, headers)
+		if err != nil {
+			endAttempt(span, nil, err)
+			return nil, err
+		}
+
+		cached := c.conditionalRequest(req)
+
+		if attempt == 1 && body != nil && c.debugEnabled(ctx) {
+			c.logger.Debugf("Request body: %s", c.redactBody(req.URL.Path, body))
+		}
+
+		// Fail fast while the provider circuit is open
+		circuitDone, err := c.allowCircuit(ctx, req)
+		if err != nil {
+			endAttempt(span, nil, err)
+			c.StructuredLogger().Log(ctx, logger.LevelWarn, "Request rejected by the circuit breaker", attemptFields(req, attempt, nil, err)...)
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		start := time.Now()
+		c.traceRequestStart(attemptCtx, method, url, attempt)
+
+		// Execute request through the middleware
+		resp, err := c.roundTrip(attemptCtx, req, c.do)
+		circuitDone(resp, err)
+		endAttempt(span, resp, err)
+		c.traceRequestDone(attemptCtx, method, url, attempt, resp, err, start)
+
+		fields := attemptFields(req, attempt, resp, err)
+		log := c.StructuredLogger()
+
+		var prepErr *prepareError
+		if errors.As(err, &prepErr) {
+			log.Log(ctx, logger.LevelError, "Failed to prepare request", fields...)
+			return nil, fmt.Errorf("failed to prepare request: %w", prepErr.err)
+		}
+
+		log.Log(ctx, logger.LevelDebug, "Attempt completed", append(fields, logger.F(logger.KeyDuration, time.Since(start)))...)
+
+		if retryPolicy != nil {
+			if delay, ok := retryPolicy.ShouldRetry(ctx, attempt, req, resp, err); ok {
+				log.Log(ctx, logger.LevelWarn, "Attempt failed, retrying", append(fields, logger.F("delay", delay))...)
+				c.traceRetry(ctx, method, url, attempt, resp, err, delay)
+				discardBody(resp)
+
+				if err := sleep(ctx, delay); err != nil {
+					log.Log(ctx, logger.LevelError, "Request failed", append(fields, logger.F(logger.KeyError, err))...)
+					return nil, fmt.Errorf("request failed: %w", err)
+				}
+
+				continue
+			}
+		}
+
+		if err != nil {
+			log.Log(ctx, logger.LevelError, "Request failed", fields...)
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		return c.cacheResponse(req, cached, resp), nil
+	}
+}
+
+// prepareError marks a failure of the middleware while preparing the
+// request, as opposed to a failure of the exchange itself. Such failures are
+// never retried.
+type prepareError struct {
+	err error
+}
+
+func (e *prepareError) Error() string { return e.err.Error() }
+func (e *prepareError) Unwrap() error { return e.err }
+
+// roundTrip runs the middleware around a single attempt, sent by next.
+// Round-trip capable middleware wraps the whole exchange; request-only
+// middleware runs before it.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request, next func(context.Context, *http.Request) (*http.Response, error)) (*http.Response, error) {
+	if roundTripper, ok := c.middleware.(interceptor.RoundTripper); ok {
+		resp, err := roundTripper.RoundTrip(ctx, req, next)
+		if errors.Is(err, interceptor.ErrInterceptFuncFailed) || errors.Is(err, interceptor.ErrInvalidHTTPRequest) {
+			return nil, &prepareError{err: err}
+		}
+
+		return resp, err
 	}
 
 	if err := c.middleware.Intercept(ctx, req); err != nil {
-		c.logger.Errorf("Failed to prepare request: %v", err)
-		return nil, fmt.Errorf("failed to prepare request: %w", err)
+		return nil, &prepareError{err: err}
+	}
+
+	return next(ctx, req)
+}
+
+// do sends a single attempt, holding a rate limiter slot until the response
+// body is closed, as it is streamed to the caller. With compression, the request body is compressed
+// when the server accepts it and the response body is decompressed. The
+// response body is streamed to the caller; it is only buffered when debug
+// logging is enabled, so it can be logged.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	debug := c.debugEnabled(ctx)
+	original := c.compressRequest(req)
+
+	// Log all headers after auth, masking credentials
+	if debug {
+		c.logger.Debugf("Request headers (final): %v", c.redactHeaders(req.Header))
+	}
+
+	release := func() {}
+	if c.rateLimiter != nil {
+		acquired, err := c.rateLimiter.Acquire(ctx, req.Method)
+		if err != nil {
+			return nil, err
+		}
+		release = sync.OnceFunc(acquired)
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		c.logger.Errorf("Request failed: %v", err)
-		return nil, fmt.Errorf("request failed: %w", err)
+		release()
+		return nil, err
 	}
 
-	c.logger.Debugf("Received response with status: %d %s", resp.StatusCode, resp.Status)
+	// The server rejected the compressed body: send it uncompressed
+	if original != nil && resp.StatusCode == http.StatusUnsupportedMediaType {
+		c.compression.requestEncodings.Store(req.URL.Host, false)
+		discardBody(resp)
 
-	if resp.Body != nil {
-		respBodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			c.logger.Warnf("Failed to read response body for logging: %v", err)
-		} else {
-			c.logger.Debugf("Response body: %s", string(respBodyBytes))
-			resp.Body = io.NopCloser(bytes.NewReader(respBodyBytes))
+		c.logger.Debugf("Compressed request body rejected, sending it uncompressed")
+		req = uncompressedRequest(req, original)
+		if resp, err = c.httpClient.Do(req); err != nil {
+			release()
+			return nil, err
 		}
 	}
 
-	return resp, nil
+	c.learnRequestEncodings(req, resp)
+	decompressResponse(resp)
+
+	c.logger.Debugf("Received response with status: %d %s", resp.StatusCode, resp.Status)
+
+	if debug {
+		c.logger.Debugf("Response headers: %v", c.redactHeaders(resp.Header))
+		c.logResponseBody(req, resp)
+	}
+
+	return releaseOnClose(resp, release), nil
 }
 
-// DoRequest performs an HTTP request with automatic authentication token injection
-func (c *Client) DoRequest(ctx context.Context, method, path string, body io.Reader, queryParams map[string]string, headers map[string]string) (*http.Response, error) {
-	// Build full URL
-	url := c.baseURL + path
+// logResponseBody logs the response body, masking sensitive fields, and
+// replaces it with a reader over the same bytes so it can still be read by
+// the caller.
+func (c *Client) logResponseBody(req *http.Request, resp *http.Response) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return
+	}
 
-	c.logger.Debugf("Making %s request to %s", method, url)
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		c.logger.Warnf("Failed to read response body for logging: %v", err)
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err}))
+		return
+	}
 
-	// Read body for logging if present
-	var bodyBytes []byte
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	c.logger.Debugf("Response body: %s", c.redactBody(req.URL.Path, body))
+}
+
+// errReader fails every read with err, so a body which could not be buffered
+// reports the failure to the caller.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// newRequest builds a single attempt of the request, before the middleware
+// runs.
+func (c *Client) newRequest(ctx context.Context, method, url string, body []byte, queryParams map[string]string, headers map[string]string) (*http.Request, error) {
+	var bodyReader io.Reader
 	if body != nil {
-		var err error
-		bodyBytes, err = io.ReadAll(body)
-		if err != nil {
-			c.logger.Errorf("Failed to read request body: %v", err)
-			return nil, fmt.Errorf("failed to read request body: %w", err)
-		}
-		c.logger.Debugf("Request body: %s", string(bodyBytes))
-		// Recreate reader for actual request
-		body = bytes.NewReader(bodyBytes)
+		bodyReader = bytes.NewReader(body)
 	}
 
 	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
 		c.logger.Errorf("Failed to create request: %v", err)
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -155,49 +386,70 @@ func (c *Client) DoRequest(ctx context.Context, method, path string, body io.Rea
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	// Log request headers (before auth)
-	c.logger.Debugf("Request headers (pre-auth): %v", headers)
+	injectTraceContext(req)
 
-	// Use middleware
-	if err := c.middleware.Intercept(ctx, req); err != nil {
-		c.logger.Errorf("Failed to prepare request: %v", err)
-		return nil, fmt.Errorf("failed to prepare request: %w", err)
+	// Log request headers (before auth), masking credentials
+	if c.debugEnabled(ctx) {
+		c.logger.Debugf("Request headers (pre-auth): %v", c.redactHeaders(req.Header))
 	}
 
-	// Log all headers after auth (excluding Authorization token for security)
-	sanitizedHeaders := make(map[string]string)
-	for key, values := range req.Header {
-		if key == "Authorization" {
-			sanitizedHeaders[key] = "Bearer [REDACTED]"
-		} else {
-			sanitizedHeaders[key] = values[0]
-		}
+	return req, nil
+}
+
+// attemptFields returns the fields describing the outcome of an attempt:
+// method, path and attempt number, followed by either the status code or the
+// error.
+func attemptFields(req *http.Request, attempt int, resp *http.Response, err error) []logger.Field {
+	fields := []logger.Field{
+		logger.F(logger.KeyMethod, req.Method),
+		logger.F(logger.KeyPath, req.URL.Path),
+		logger.F(logger.KeyAttempt, attempt),
 	}
-	c.logger.Debugf("Request headers (final): %v", sanitizedHeaders)
 
-	// Execute request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		c.logger.Errorf("Request failed: %v", err)
-		return nil, fmt.Errorf("request failed: %w", err)
+	if requestID := req.Header.Get(RequestIDHeader); requestID != "" {
+		fields = append(fields, logger.F(logger.KeyRequestID, requestID))
 	}
 
-	c.logger.Debugf("Received response with status: %d %s", resp.StatusCode, resp.Status)
+	var prepErr *prepareError
+	switch {
+	case errors.As(err, &prepErr):
+		fields = append(fields, logger.F(logger.KeyError, prepErr.err))
+	case err != nil:
+		fields = append(fields, logger.F(logger.KeyError, err))
+	case resp != nil:
+		fields = append(fields, logger.F(logger.KeyStatus, resp.StatusCode))
+	}
+
+	// Cap the slice, so the fields appended by each record do not overwrite
+	// each other.
+	return fields[:len(fields):len(fields)]
+}
 
-	// Log response headers
-	c.logger.Debugf("Response headers: %v", resp.Header)
+// discardBody drains and closes the body of a response that is not going to
+// be returned, so the underlying connection can be reused.
+func discardBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
 
-	// Log response body (for debugging)
-	if resp.Body != nil {
-		respBodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			c.logger.Warnf("Failed to read response body for logging: %v", err)
-		} else {
-			c.logger.Debugf("Response body: %s", string(respBodyBytes))
-			// Recreate the response body so it can be read by the caller
-			resp.Body = io.NopCloser(bytes.NewReader(respBodyBytes))
-		}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// sleep waits for the given delay, returning early with the context error if
+// the context is done first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
 	}
 
-	return resp, nil
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
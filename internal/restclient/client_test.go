@@ -2,14 +2,23 @@ package restclient
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/Arubacloud/sdk-go/internal/impl/circuitbreaker/consecutive"
 	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
 	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+	"github.com/Arubacloud/sdk-go/internal/impl/ratelimit/tokenbucket"
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+	"github.com/Arubacloud/sdk-go/internal/ports/interceptor"
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
 )
 
 func newTestRestClient(t *testing.T, baseURL string) *Client {
@@ -125,3 +134,502 @@ func TestDoRequestAbs_WithBody(t *testing.T) {
 		t.Errorf("Content-Type = %q, want %q", capturedContentType, "application/json")
 	}
 }
+
+// countingPolicy retries every failed attempt without delay until maxAttempts.
+type countingPolicy struct {
+	maxAttempts int
+	calls       int
+}
+
+func (p *countingPolicy) ShouldRetry(ctx context.Context, attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	p.calls++
+	if attempt >= p.maxAttempts {
+		return 0, false
+	}
+	return 0, err != nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func TestDoRequest_RetriesRewindBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	policy := &countingPolicy{maxAttempts: 5}
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRetryPolicy(policy))
+
+	resp, err := client.DoRequest(context.Background(), http.MethodPut, "/resource", strings.NewReader(`{"key":"value"}`), nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(bodies))
+	}
+	for i, b := range bodies {
+		if b != `{"key":"value"}` {
+			t.Errorf("attempt %d body = %q, want the original body", i+1, b)
+		}
+	}
+}
+
+func TestDoRequest_RetriesExhausted(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`{"status":502}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRetryPolicy(&countingPolicy{maxAttempts: 3}))
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadGateway || !strings.Contains(string(body), "502") {
+		t.Errorf("last response should be returned to the caller, got %d %q", resp.StatusCode, body)
+	}
+}
+
+func TestDoRequest_RetryStopsOnContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := retryFunc(func(context.Context, int, *http.Request, *http.Response, error) (time.Duration, bool) {
+		cancel()
+		return time.Hour, true
+	})
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRetryPolicy(policy))
+
+	_, err := client.DoRequest(ctx, http.MethodGet, "/resource", nil, nil, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("DoRequest() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestDoRequest_NoRetryPolicyMakesSingleAttempt(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestRestClient(t, server.URL)
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+type retryFunc func(ctx context.Context, attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+
+func (f retryFunc) ShouldRetry(ctx context.Context, attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	return f(ctx, attempt, req, resp, err)
+}
+
+// recordingLimiter records the methods it is asked to admit and counts releases.
+type recordingLimiter struct {
+	methods  []string
+	released int
+	err      error
+}
+
+func (l *recordingLimiter) Acquire(ctx context.Context, method string) (func(), error) {
+	l.methods = append(l.methods, method)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return func() { l.released++ }, nil
+}
+
+func TestDoRequest_WaitsOnRateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	limiter := &recordingLimiter{}
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRateLimiter(limiter))
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		resp, err := client.DoRequest(context.Background(), method, "/resource", nil, nil, nil)
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(limiter.methods) != 2 || limiter.methods[0] != http.MethodGet || limiter.methods[1] != http.MethodPost {
+		t.Errorf("limiter saw methods %v, want [GET POST]", limiter.methods)
+	}
+	if limiter.released != 2 {
+		t.Errorf("released = %d, want 2", limiter.released)
+	}
+}
+
+func TestDoRequest_RateLimiterErrorAbortsRequest(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	t.Cleanup(server.Close)
+
+	limiter := &recordingLimiter{err: context.DeadlineExceeded}
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRateLimiter(limiter))
+
+	_, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DoRequest() error = %v, want context.DeadlineExceeded", err)
+	}
+	if called {
+		t.Error("request should not reach the server")
+	}
+}
+
+func TestDoRequest_RateLimiterSlotHeldUntilBodyClosed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"resource"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	limiter := tokenbucket.NewLimiter(0, 0, 0, 0, 1)
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRateLimiter(limiter))
+
+	first, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+		if err == nil {
+			resp.Body.Close()
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("second request completed while the first body was open, error = %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	first.Body.Close()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("second request still blocked after the first body was closed")
+	}
+}
+
+func TestDoRequest_RoundTripMiddlewareSeesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Trace-Id", "trace-123")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var traceID, body string
+	middleware := standard.NewInterceptor()
+	err := middleware.BindMiddleware(func(ctx context.Context, r *http.Request, next interceptor.RoundTripFunc) (*http.Response, error) {
+		resp, err := next(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		traceID = resp.Header.Get("X-Trace-Id")
+		b, _ := io.ReadAll(resp.Body)
+		body = string(b)
+		resp.Body = io.NopCloser(strings.NewReader(body))
+		return resp, nil
+	})
+	if err != nil {
+		t.Fatalf("BindMiddleware() error = %v", err)
+	}
+
+	client := NewClient(server.URL, http.DefaultClient, middleware, &noop.NoOpLogger{})
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if traceID != "trace-123" {
+		t.Errorf("middleware saw trace ID %q, want %q", traceID, "trace-123")
+	}
+	callerBody, _ := io.ReadAll(resp.Body)
+	if body != `{"status":"ok"}` || string(callerBody) != body {
+		t.Errorf("middleware body = %q, caller body = %q", body, callerBody)
+	}
+}
+
+func TestDoRequest_InterceptFailureIsNotRetried(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	t.Cleanup(server.Close)
+
+	middleware, _ := standard.NewInterceptorWithFuncs(func(ctx context.Context, r *http.Request) error {
+		return errors.New("no token")
+	})
+	policy := &countingPolicy{maxAttempts: 3}
+	client := NewClient(server.URL, http.DefaultClient, middleware, &noop.NoOpLogger{}, WithRetryPolicy(policy))
+
+	_, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to prepare request") {
+		t.Fatalf("DoRequest() error = %v, want a prepare failure", err)
+	}
+	if !errors.Is(err, interceptor.ErrInterceptFuncFailed) {
+		t.Errorf("DoRequest() error = %v, want ErrInterceptFuncFailed", err)
+	}
+	if called || policy.calls != 0 {
+		t.Errorf("request should neither be sent nor retried (sent=%v, policy calls=%d)", called, policy.calls)
+	}
+}
+
+// recordingLogger records the structured records it receives.
+type recordingLogger struct {
+	noop.NoOpLogger
+	records []recordedLog
+}
+
+type recordedLog struct {
+	level  logger.Level
+	msg    string
+	fields map[string]any
+}
+
+func (l *recordingLogger) Enabled(ctx context.Context, level logger.Level) bool { return true }
+
+func (l *recordingLogger) Log(ctx context.Context, level logger.Level, msg string, fields ...logger.Field) {
+	record := recordedLog{level: level, msg: msg, fields: make(map[string]any)}
+	for _, f := range fields {
+		record.fields[f.Key] = f.Value
+	}
+	l.records = append(l.records, record)
+}
+
+func TestDoRequest_StructuredLogging(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	log := &recordingLogger{}
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), log, WithRetryPolicy(&countingPolicy{maxAttempts: 3}))
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/projects/p-1", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	resp.Body.Close()
+
+	var retried, completed []recordedLog
+	for _, r := range log.records {
+		switch r.level {
+		case logger.LevelWarn:
+			retried = append(retried, r)
+		case logger.LevelDebug:
+			completed = append(completed, r)
+		}
+	}
+
+	if len(retried) != 1 || len(completed) != 2 {
+		t.Fatalf("records = %+v, want 2 completed attempts and 1 retry", log.records)
+	}
+
+	want := map[string]any{
+		logger.KeyMethod:  http.MethodGet,
+		logger.KeyPath:    "/projects/p-1",
+		logger.KeyAttempt: 1,
+		logger.KeyStatus:  http.StatusServiceUnavailable,
+	}
+	for key, value := range want {
+		if got := retried[0].fields[key]; got != value {
+			t.Errorf("retry field %s = %v, want %v", key, got, value)
+		}
+	}
+
+	if got := completed[1].fields[logger.KeyAttempt]; got != 2 {
+		t.Errorf("second attempt field %s = %v, want 2", logger.KeyAttempt, got)
+	}
+	if _, ok := completed[1].fields[logger.KeyDuration].(time.Duration); !ok {
+		t.Errorf("completed attempt should carry a %s field", logger.KeyDuration)
+	}
+}
+
+func TestClient_StructuredLoggerShim(t *testing.T) {
+	var nilClient *Client
+	nilClient.StructuredLogger().Log(context.Background(), logger.LevelError, "discarded")
+
+	printf := &printfLogger{}
+	client := NewClient("", http.DefaultClient, nil, printf)
+	client.StructuredLogger().Log(context.Background(), logger.LevelWarn, "Attempt failed", logger.F(logger.KeyStatus, 503))
+
+	if len(printf.lines) != 1 || printf.lines[0] != "WARN Attempt failed status=503" {
+		t.Errorf("printf lines = %q, want the record rendered as key=value pairs", printf.lines)
+	}
+}
+
+// printfLogger is a Logger exposing only the printf methods.
+type printfLogger struct {
+	lines []string
+}
+
+func (l *printfLogger) Debugf(format string, args ...interface{}) { l.add("DEBUG", format, args) }
+func (l *printfLogger) Infof(format string, args ...interface{})  { l.add("INFO", format, args) }
+func (l *printfLogger) Warnf(format string, args ...interface{})  { l.add("WARN", format, args) }
+func (l *printfLogger) Errorf(format string, args ...interface{}) { l.add("ERROR", format, args) }
+
+func (l *printfLogger) add(level, format string, args []interface{}) {
+	l.lines = append(l.lines, level+" "+fmt.Sprintf(format, args...))
+}
+
+func TestDoRequest_CircuitBreaker(t *testing.T) {
+	hits := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits[r.URL.Path]++
+		if strings.Contains(r.URL.Path, "Aruba.Database") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{},
+		WithCircuitBreaker(consecutive.NewBreaker(2, time.Minute, 1)))
+
+	const dbaas = "/projects/p/providers/Aruba.Database/dbaas"
+	for range 2 {
+		resp, err := client.DoRequest(context.Background(), http.MethodGet, dbaas, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	_, err := client.DoRequest(context.Background(), http.MethodGet, dbaas, nil, nil, nil)
+	var openErr *circuitbreaker.OpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, circuitbreaker.ErrCircuitOpen) {
+		t.Fatalf("DoRequest() error = %v, want an open circuit error", err)
+	}
+	if openErr.Key != "providers/Aruba.Database" {
+		t.Errorf("open circuit key = %q, want %q", openErr.Key, "providers/Aruba.Database")
+	}
+	if hits[dbaas] != 2 {
+		t.Errorf("server hits = %d, want 2: the rejected request must not be sent", hits[dbaas])
+	}
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/projects/p/providers/Aruba.Compute/cloudServers", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() to another provider error = %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestCircuitKey(t *testing.T) {
+	tests := map[string]string{
+		"/projects/p/providers/Aruba.Compute/cloudServers/cs": "providers/Aruba.Compute",
+		"/api/projects/p/providers/Aruba.Network/vpcs":        "providers/Aruba.Network",
+		"/projects/p":            "",
+		"/projects/p/providers/": "",
+	}
+
+	for path, want := range tests {
+		if got := circuitKey(path); got != want {
+			t.Errorf("circuitKey(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestDoRequest_StreamsResponseBody(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"values":[`))
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte(`]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{})
+
+	// The response must be returned before the server completes the body
+	timeout := time.AfterFunc(5*time.Second, func() { close(release) })
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/audit", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if !timeout.Stop() {
+		t.Error("DoRequest() waited for the whole body")
+	} else {
+		close(release)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != `{"values":[]}` {
+		t.Errorf("body = %q, %v", body, err)
+	}
+}
+
+func TestDoRequest_BuffersResponseBodyForDebugLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"p-1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	printf := &printfLogger{}
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), printf)
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/projects/p-1", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != `{"id":"p-1"}` {
+		t.Errorf("body = %q, %v, want it readable after logging", body, err)
+	}
+	if !slices.Contains(printf.lines, `DEBUG Response body: {"id":"p-1"}`) {
+		t.Errorf("printf lines = %q, want the response body logged", printf.lines)
+	}
+}
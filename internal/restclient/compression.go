@@ -0,0 +1,167 @@
+package restclient
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Compression configures the gzip compression of the exchanges.
+type Compression struct {
+	// MinRequestSize is the size, in bytes, from which request bodies are
+	// compressed, once the server has advertised gzip support.
+	MinRequestSize int
+
+	// requestEncodings records, per host, whether the server accepts
+	// gzip-encoded request bodies, as advertised by the Accept-Encoding
+	// header of its responses (RFC 7694).
+	requestEncodings sync.Map
+}
+
+// WithCompression asks the servers for gzip-compressed responses, which are
+// decompressed before being logged and returned, whatever the compression
+// settings of the HTTP client. Request bodies of at least
+// compression.MinRequestSize bytes are compressed for the hosts advertising
+// gzip support. A nil compression disables it, which is the default.
+func WithCompression(compression *Compression) ClientOption {
+	return func(c *Client) {
+		c.compression = compression
+	}
+}
+
+// compressRequest asks for a compressed response and compresses the body of
+// req when the server accepts it. It returns the uncompressed body when the
+// body was compressed, nil otherwise.
+func (c *Client) compressRequest(req *http.Request) []byte {
+	if c.compression == nil {
+		return nil
+	}
+
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	if req.GetBody == nil || req.ContentLength < int64(c.compression.MinRequestSize) || req.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+	if accepted, _ := c.compression.requestEncodings.Load(req.URL.Host); accepted != true {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	original, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(original); err != nil || zw.Close() != nil {
+		return nil
+	}
+
+	setRequestBody(req, compressed.Bytes())
+	req.Header.Set("Content-Encoding", "gzip")
+
+	return original
+}
+
+// uncompressedRequest returns a copy of req, whose body was compressed by
+// compressRequest, carrying the original body instead.
+func uncompressedRequest(req *http.Request, original []byte) *http.Request {
+	uncompressed := req.Clone(req.Context())
+	setRequestBody(uncompressed, original)
+	uncompressed.Header.Del("Content-Encoding")
+
+	return uncompressed
+}
+
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+}
+
+// learnRequestEncodings records whether the host of req accepts gzip-encoded
+// request bodies, when its response says so.
+func (c *Client) learnRequestEncodings(req *http.Request, resp *http.Response) {
+	if c.compression == nil {
+		return
+	}
+
+	if values := resp.Header.Values("Accept-Encoding"); len(values) > 0 {
+		c.compression.requestEncodings.Store(req.URL.Host, acceptsGzip(values))
+	}
+}
+
+// acceptsGzip reports whether the Accept-Encoding values list gzip with a
+// non-zero quality.
+func acceptsGzip(values []string) bool {
+	for _, value := range values {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+			if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+				continue
+			}
+
+			for _, param := range strings.Split(params, ";") {
+				key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); strings.EqualFold(key, "q") && err == nil && q == 0 {
+					return false
+				}
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// decompressResponse replaces a gzip-encoded response body with a reader of
+// the decompressed content.
+func decompressResponse(resp *http.Response) {
+	if resp.Body == nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return
+	}
+
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+}
+
+// gzipBody decompresses a response body, lazily so that an empty body reads
+// as such.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.zr == nil && b.err == nil {
+		b.zr, b.err = gzip.NewReader(b.body)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+
+	return b.zr.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,159 @@
+package restclient
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+)
+
+func gzipped(t *testing.T, data string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(data)); err != nil {
+		t.Fatalf("gzip Write() error = %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("gzip Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestDoRequest_DecompressesResponses(t *testing.T) {
+	const payload = `{"values":[{"id":"event-1"}]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gzipped(t, payload))
+	}))
+	t.Cleanup(server.Close)
+
+	// The transport of the client does not decompress responses itself
+	httpClient := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+	printf := &printfLogger{}
+	client := NewClient(server.URL, httpClient, standard.NewInterceptor(), printf, WithCompression(&Compression{}))
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/events", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != payload {
+		t.Errorf("body = %q, %v, want the decompressed payload", body, err)
+	}
+	if resp.Header.Get("Content-Encoding") != "" || !resp.Uncompressed {
+		t.Errorf("response headers = %v (uncompressed %v), want the encoding removed", resp.Header, resp.Uncompressed)
+	}
+	if !slices.Contains(printf.lines, "DEBUG Response body: "+payload) {
+		t.Errorf("printf lines = %q, want the decompressed body logged", printf.lines)
+	}
+}
+
+func TestDoRequest_CompressesRequestBodies(t *testing.T) {
+	var encodings []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+
+		body := io.Reader(r.Body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Errorf("gzip.NewReader() error = %v", err)
+				return
+			}
+			body = zr
+		}
+		if data, _ := io.ReadAll(body); !strings.HasPrefix(string(data), `{"name":`) {
+			t.Errorf("request body = %q, want the JSON payload", data)
+		}
+
+		w.Header().Set("Accept-Encoding", "gzip, deflate")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithCompression(&Compression{MinRequestSize: 64}))
+
+	large := `{"name":"` + strings.Repeat("x", 128) + `"}`
+	for _, body := range []string{large, large, `{"name":"small"}`} {
+		resp, err := client.DoRequest(context.Background(), http.MethodPost, "/vpcs", strings.NewReader(body), nil, nil)
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first body is sent before the server has advertised gzip support
+	if want := []string{"", "gzip", ""}; !slices.Equal(encodings, want) {
+		t.Errorf("Content-Encoding of the requests = %q, want %q", encodings, want)
+	}
+}
+
+func TestDoRequest_CompressedRequestRejected(t *testing.T) {
+	var encodings []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+
+		switch {
+		case r.Method == http.MethodGet:
+			w.Header().Set("Accept-Encoding", "gzip")
+		case r.Header.Get("Content-Encoding") != "":
+			w.Header().Set("Accept-Encoding", "identity")
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithCompression(&Compression{}))
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodPut} {
+		resp, err := client.DoRequest(context.Background(), method, "/vpcs/v", strings.NewReader(`{"name":"vpc"}`), nil, nil)
+		if err != nil {
+			t.Fatalf("DoRequest() error = %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s status = %d, want 200", method, resp.StatusCode)
+		}
+	}
+
+	if want := []string{"", "gzip", "", ""}; !slices.Equal(encodings, want) {
+		t.Errorf("Content-Encoding of the requests = %q, want %q", encodings, want)
+	}
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   bool
+	}{
+		{[]string{"gzip"}, true},
+		{[]string{"identity", "GZIP;q=0.5"}, true},
+		{[]string{"br, gzip ; q=0"}, false},
+		{[]string{"identity"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := acceptsGzip(tt.values); got != tt.want {
+			t.Errorf("acceptsGzip(%q) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
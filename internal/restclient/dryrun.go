@@ -0,0 +1,117 @@
+package restclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ErrDryRun is returned, wrapped, by the mutating requests captured into the
+// dry-run plan instead of being sent.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// PlannedRequest is a mutating request captured in dry-run mode. Credentials
+// are masked in Header and sensitive fields in Body.
+type PlannedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// Plan collects the requests captured in dry-run mode, in the order they
+// would have been sent. It is safe for concurrent use.
+type Plan struct {
+	locker   sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns a copy of the captured requests.
+func (p *Plan) Requests() []PlannedRequest {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+// Reset discards the captured requests.
+func (p *Plan) Reset() {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	p.requests = nil
+}
+
+func (p *Plan) add(req PlannedRequest) {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	p.requests = append(p.requests, req)
+}
+
+// WithDryRun makes the client capture every mutating request (POST, PUT,
+// PATCH, DELETE) into its plan instead of sending it, when enabled. Other
+// requests are sent as usual. Dry-run mode is disabled by default.
+func WithDryRun(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.dryRun = enabled
+	}
+}
+
+type dryRunContextKey struct{}
+
+// ContextWithDryRun returns a context under which mutating requests are
+// captured into the plan of the client, as if it was in dry-run mode.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+// Plan returns the requests captured in dry-run mode.
+func (c *Client) Plan() *Plan {
+	return c.plan
+}
+
+// isDryRun reports whether a request must be captured instead of sent.
+func (c *Client) isDryRun(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	dryRun, _ := ctx.Value(dryRunContextKey{}).(bool)
+	return c.dryRun || dryRun
+}
+
+// capture builds the request and runs the middleware, so that it carries its
+// final headers, then adds it to the plan in place of sending it.
+func (c *Client) capture(ctx context.Context, method, url string, body []byte, queryParams map[string]string, headers map[string]string) error {
+	req, err := c.newRequest(ctx, method, url, body, queryParams, headers)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.roundTrip(ctx, req, func(_ context.Context, req *http.Request) (*http.Response, error) {
+		planned := PlannedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: c.redactHeaders(req.Header),
+		}
+		if body != nil {
+			planned.Body = c.redactBody(req.URL.Path, body)
+		}
+		c.plan.add(planned)
+
+		c.logger.Debugf("Dry run: captured %s %s", req.Method, req.URL)
+
+		return nil, fmt.Errorf("%w: %s %s", ErrDryRun, req.Method, req.URL)
+	})
+
+	var prepErr *prepareError
+	if errors.As(err, &prepErr) {
+		return fmt.Errorf("failed to prepare request: %w", prepErr.err)
+	}
+
+	return err
+}
@@ -0,0 +1,104 @@
+package restclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+	"github.com/Arubacloud/sdk-go/internal/ports/redact"
+)
+
+func newDryRunServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func TestDoRequest_DryRun(t *testing.T) {
+	server, hits := newDryRunServer(t)
+
+	middleware, err := standard.NewInterceptorWithFuncs(func(_ context.Context, r *http.Request) error {
+		r.Header.Set("Authorization", "Bearer secret-token")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewInterceptorWithFuncs() error = %v", err)
+	}
+	client := NewClient(server.URL, http.DefaultClient, middleware, &noop.NoOpLogger{}, WithDryRun(true))
+
+	body := []byte(`{"name":"vpc"}`)
+	_, err = client.DoRequest(context.Background(), http.MethodPost, "/projects/p/vpcs", bytes.NewReader(body),
+		map[string]string{"api-version": "1.0"}, map[string]string{"X-Custom": "1"})
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("POST error = %v, want ErrDryRun", err)
+	}
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/projects/p", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("GET error = %v", err)
+	}
+	resp.Body.Close()
+
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hits = %d, want only the GET", got)
+	}
+
+	requests := client.Plan().Requests()
+	if len(requests) != 1 {
+		t.Fatalf("plan = %+v, want one request", requests)
+	}
+	planned := requests[0]
+	if planned.Method != http.MethodPost || planned.URL != server.URL+"/projects/p/vpcs?api-version=1.0" {
+		t.Errorf("planned request = %s %s", planned.Method, planned.URL)
+	}
+	if got := planned.Header.Get("Authorization"); got != "Bearer "+redact.Mask {
+		t.Errorf("Authorization = %q, want it masked", got)
+	}
+	if planned.Header.Get("X-Custom") != "1" || planned.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("planned headers = %v", planned.Header)
+	}
+	if string(planned.Body) != string(body) {
+		t.Errorf("planned body = %s, want %s", planned.Body, body)
+	}
+
+	client.Plan().Reset()
+	if got := client.Plan().Requests(); len(got) != 0 {
+		t.Errorf("plan after Reset = %+v, want it empty", got)
+	}
+}
+
+func TestDoRequest_DryRunContext(t *testing.T) {
+	server, hits := newDryRunServer(t)
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{})
+
+	_, err := client.DoRequest(ContextWithDryRun(context.Background()), http.MethodDelete, "/projects/p", nil, nil, nil)
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("DELETE error = %v, want ErrDryRun", err)
+	}
+
+	resp, err := client.DoRequest(context.Background(), http.MethodDelete, "/projects/p", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DELETE error = %v", err)
+	}
+	resp.Body.Close()
+
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hits = %d, want only the second DELETE", got)
+	}
+	if got := client.Plan().Requests(); len(got) != 1 || got[0].Body != nil {
+		t.Errorf("plan = %+v, want the first DELETE without body", got)
+	}
+}
@@ -0,0 +1,74 @@
+package restclient
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
+)
+
+// WithClientTrace sets the hooks called for every attempt and retry. The
+// hooks are also made available to the callers of the client through
+// ClientTrace. A nil trace disables the hooks, which is the default.
+func WithClientTrace(trace *clienttrace.ClientTrace) ClientOption {
+	return func(c *Client) {
+		c.clientTrace = trace
+	}
+}
+
+// ClientTrace returns the client hooks. It never returns nil: an empty set
+// of hooks is returned when none is configured or the client itself is nil.
+func (c *Client) ClientTrace() *clienttrace.ClientTrace {
+	if c == nil || c.clientTrace == nil {
+		return &clienttrace.ClientTrace{}
+	}
+
+	return c.clientTrace
+}
+
+func (c *Client) traceRequestStart(ctx context.Context, method, url string, attempt int) {
+	if hook := c.ClientTrace().RequestStart; hook != nil {
+		hook(ctx, clienttrace.RequestStartInfo{Method: method, URL: url, Attempt: attempt})
+	}
+}
+
+func (c *Client) traceRequestDone(ctx context.Context, method, url string, attempt int, resp *http.Response, err error, start time.Time) {
+	hook := c.ClientTrace().RequestDone
+	if hook == nil {
+		return
+	}
+
+	info := clienttrace.RequestDoneInfo{
+		Method:  method,
+		URL:     url,
+		Attempt: attempt,
+		Err:     err,
+		Latency: time.Since(start),
+	}
+	if err == nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	hook(ctx, info)
+}
+
+func (c *Client) traceRetry(ctx context.Context, method, url string, attempt int, resp *http.Response, err error, delay time.Duration) {
+	hook := c.ClientTrace().Retry
+	if hook == nil {
+		return
+	}
+
+	info := clienttrace.RetryInfo{
+		Method:  method,
+		URL:     url,
+		Attempt: attempt,
+		Err:     err,
+		Delay:   delay,
+	}
+	if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	hook(ctx, info)
+}
@@ -0,0 +1,70 @@
+package restclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
+)
+
+func TestDoRequest_ClientTraceHooks(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	var starts []clienttrace.RequestStartInfo
+	var dones []clienttrace.RequestDoneInfo
+	var retries []clienttrace.RetryInfo
+	trace := &clienttrace.ClientTrace{
+		RequestStart: func(_ context.Context, info clienttrace.RequestStartInfo) { starts = append(starts, info) },
+		RequestDone:  func(_ context.Context, info clienttrace.RequestDoneInfo) { dones = append(dones, info) },
+		Retry:        func(_ context.Context, info clienttrace.RetryInfo) { retries = append(retries, info) },
+	}
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{},
+		WithRetryPolicy(&countingPolicy{maxAttempts: 3}),
+		WithClientTrace(trace),
+	)
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if len(starts) != 2 || starts[0].Attempt != 1 || starts[1].Attempt != 2 {
+		t.Fatalf("RequestStart calls = %+v, want attempts 1 and 2", starts)
+	}
+	if starts[0].Method != http.MethodGet || starts[0].URL != server.URL+"/resource" {
+		t.Errorf("RequestStart info = %+v", starts[0])
+	}
+	if len(dones) != 2 || dones[0].StatusCode != http.StatusServiceUnavailable || dones[1].StatusCode != http.StatusOK {
+		t.Fatalf("RequestDone calls = %+v, want 503 then 200", dones)
+	}
+	if dones[1].Latency <= 0 {
+		t.Errorf("RequestDone latency = %s, want a positive duration", dones[1].Latency)
+	}
+	if len(retries) != 1 || retries[0].Attempt != 1 || retries[0].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Retry calls = %+v, want one retry of attempt 1", retries)
+	}
+}
+
+func TestClient_ClientTraceNeverNil(t *testing.T) {
+	var nilClient *Client
+	if nilClient.ClientTrace() == nil {
+		t.Error("ClientTrace() of a nil client should not be nil")
+	}
+	if newTestRestClient(t, "http://localhost").ClientTrace() == nil {
+		t.Error("ClientTrace() without hooks should not be nil")
+	}
+}
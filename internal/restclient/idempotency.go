@@ -0,0 +1,81 @@
+package restclient
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
+)
+
+// WithIdempotencyKeys makes the client attach a key produced by newKey to
+// every mutating request (POST, PUT, PATCH, DELETE) which does not carry one
+// yet. The key is the same for all the attempts of a request. A nil function
+// disables automatic keys, which is the default.
+func WithIdempotencyKeys(newKey func() string) ClientOption {
+	return func(c *Client) {
+		c.newIdempotencyKey = newKey
+	}
+}
+
+// NewIdempotencyKey returns a fresh idempotency key, or "" when automatic
+// keys are disabled or the client itself is nil.
+func (c *Client) NewIdempotencyKey() string {
+	if c == nil || c.newIdempotencyKey == nil {
+		return ""
+	}
+
+	return c.newIdempotencyKey()
+}
+
+// withIdempotencyKey returns the headers of a request, with an idempotency
+// key added when the request is mutating, has none and automatic keys are
+// enabled. The caller map is never modified.
+func (c *Client) withIdempotencyKey(method string, headers map[string]string) map[string]string {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return headers
+	}
+
+	if c.newIdempotencyKey == nil {
+		return headers
+	}
+
+	for k := range headers {
+		if http.CanonicalHeaderKey(k) == retry.IdempotencyKeyHeader {
+			return headers
+		}
+	}
+
+	withKey := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		withKey[k] = v
+	}
+	withKey[retry.IdempotencyKeyHeader] = c.NewIdempotencyKey()
+
+	return withKey
+}
+
+// IsAmbiguous reports whether a request which failed with err may
+// nevertheless have been processed by the API: it was sent, but no response
+// was received (e.g. a timeout or a connection reset). Failures occurring
+// before the request leaves the client (the middleware, the rate limiter, the
+// circuit breaker, a connection which could not be established) are not
+// ambiguous.
+func IsAmbiguous(err error) bool {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+
+	return !errors.As(err, &dnsErr)
+}
@@ -0,0 +1,95 @@
+package restclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+)
+
+func TestDoRequest_IdempotencyKeys(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Method+" "+r.Header.Get("Idempotency-Key"))
+		// Fail the first attempt of every call.
+		if len(keys)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	generated := 0
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{},
+		WithIdempotencyKeys(func() string {
+			generated++
+			return "key-" + strconv.Itoa(generated)
+		}),
+		WithRetryPolicy(&countingPolicy{maxAttempts: 2}))
+
+	callerHeaders := map[string]string{"X-Custom": "1"}
+	for _, call := range []struct {
+		method  string
+		headers map[string]string
+	}{
+		{http.MethodPost, callerHeaders},
+		{http.MethodGet, nil},
+		{http.MethodDelete, map[string]string{"idempotency-key": "caller-key"}},
+	} {
+		resp, err := client.DoRequest(context.Background(), call.method, "/resource", nil, nil, call.headers)
+		if err != nil {
+			t.Fatalf("DoRequest(%s) error = %v", call.method, err)
+		}
+		resp.Body.Close()
+	}
+
+	// Every attempt of the POST carries the same key.
+	want := []string{"POST key-1", "POST key-1", "GET ", "GET ", "DELETE caller-key", "DELETE caller-key"}
+	if fmt.Sprint(keys) != fmt.Sprint(want) {
+		t.Errorf("keys = %q, want %q", keys, want)
+	}
+	if len(callerHeaders) != 1 {
+		t.Errorf("caller headers modified: %v", callerHeaders)
+	}
+}
+
+func TestIsAmbiguous(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		want bool
+	}{
+		"timeout": {
+			err:  fmt.Errorf("request failed: %w", &url.Error{Op: "Post", URL: "http://x", Err: context.DeadlineExceeded}),
+			want: true,
+		},
+		"connection reset": {
+			err:  &url.Error{Op: "Post", URL: "http://x", Err: &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}},
+			want: true,
+		},
+		"connection refused": {
+			err: &url.Error{Op: "Post", URL: "http://x", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}},
+		},
+		"unknown host": {
+			err: &url.Error{Op: "Post", URL: "http://x", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host"}}},
+		},
+		"circuit open": {
+			err: fmt.Errorf("request failed: %w", &circuitbreaker.OpenError{Key: "providers/Aruba.Compute", RetryAfter: time.Second}),
+		},
+		"nil": {},
+	} {
+		if got := IsAmbiguous(tc.err); got != tc.want {
+			t.Errorf("%s: IsAmbiguous() = %v, want %v", name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package restclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
+)
+
+// RequestIDHeader carries the correlation ID of a request, also logged with
+// every attempt.
+const RequestIDHeader = "X-Request-ID"
+
+type (
+	timeoutContextKey     struct{}
+	retryPolicyContextKey struct{}
+)
+
+// ContextWithTimeout returns a context under which every request sent by the
+// client, its retries included, is bounded by timeout. It cannot extend the
+// deadline of ctx, if any: the earlier of the two applies. The response body
+// stays readable until it is closed.
+func ContextWithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutContextKey{}, timeout)
+}
+
+// ContextWithRetryPolicy returns a context under which failed requests are
+// re-sent according to policy instead of the policy of the client. A nil
+// policy disables retries.
+func ContextWithRetryPolicy(ctx context.Context, policy retry.Policy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, retryPolicyOverride{policy: policy})
+}
+
+// retryPolicyOverride wraps the policy of the context, so that a nil policy
+// can be told apart from no override.
+type retryPolicyOverride struct {
+	policy retry.Policy
+}
+
+// retryPolicyFor returns the retry policy applying to the requests sent
+// under ctx.
+func (c *Client) retryPolicyFor(ctx context.Context) retry.Policy {
+	if override, ok := ctx.Value(retryPolicyContextKey{}).(retryPolicyOverride); ok {
+		return override.policy
+	}
+
+	return c.retryPolicy
+}
+
+// withTimeout applies the timeout of the context, if any. The returned
+// function releases the timer.
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout, ok := ctx.Value(timeoutContextKey{}).(time.Duration); ok && timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	return ctx, func() {}
+}
+
+// releaseOnClose defers cancel until the body of resp is closed, so it can
+// still be read once the request has returned. It is also used to release
+// the rate limiter slot of an attempt.
+func releaseOnClose(resp *http.Response, cancel context.CancelFunc) *http.Response {
+	if resp == nil || resp.Body == nil {
+		cancel()
+		return resp
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,95 @@
+package restclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+)
+
+func TestDoRequest_ContextWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.Write([]byte(`{"id":"fast"}`))
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{})
+	ctx := ContextWithTimeout(context.Background(), 50*time.Millisecond)
+
+	if _, err := client.DoRequest(ctx, http.MethodGet, "/slow", nil, nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DoRequest() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	resp, err := client.DoRequest(ctx, http.MethodGet, "/fast", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != `{"id":"fast"}` {
+		t.Errorf("body = %q, %v, want it readable after DoRequest returned", body, err)
+	}
+
+	// A longer timeout does not extend the deadline of the context.
+	deadlineCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.DoRequest(ContextWithTimeout(deadlineCtx, time.Minute), http.MethodGet, "/slow", nil, nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DoRequest() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("DoRequest() returned after %v, want the deadline of the context to apply", elapsed)
+	}
+}
+
+func TestDoRequest_ContextWithRetryPolicy(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{}, WithRetryPolicy(&countingPolicy{maxAttempts: 3}))
+
+	for _, tt := range []struct {
+		name string
+		ctx  context.Context
+		want int
+	}{
+		{name: "client policy", ctx: context.Background(), want: 3},
+		{name: "overridden policy", ctx: ContextWithRetryPolicy(context.Background(), &countingPolicy{maxAttempts: 2}), want: 2},
+		{name: "retries disabled", ctx: ContextWithRetryPolicy(context.Background(), nil), want: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts = 0
+
+			resp, err := client.DoRequest(tt.ctx, http.MethodGet, "/resource", nil, nil, nil)
+			if err != nil {
+				t.Fatalf("DoRequest() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if attempts != tt.want {
+				t.Errorf("attempts = %d, want %d", attempts, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package restclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+	"github.com/Arubacloud/sdk-go/internal/ports/redact"
+)
+
+// WithRedactor sets the redactor masking sensitive headers and body fields
+// before requests and responses are logged. Without a redactor only the
+// Authorization header is masked.
+func WithRedactor(redactor redact.Redactor) ClientOption {
+	return func(c *Client) {
+		c.redactor = redactor
+	}
+}
+
+// redactHeaders returns a copy of the header which is safe to log.
+func (c *Client) redactHeaders(header http.Header) http.Header {
+	if c.redactor != nil {
+		return c.redactor.RedactHeaders(header)
+	}
+
+	redacted := header.Clone()
+	if redacted.Get("Authorization") != "" {
+		redacted.Set("Authorization", "Bearer "+redact.Mask)
+	}
+
+	return redacted
+}
+
+// redactBody returns a copy of the body exchanged with the given URL path
+// which is safe to log.
+func (c *Client) redactBody(path string, body []byte) []byte {
+	if c.redactor == nil {
+		return body
+	}
+
+	return c.redactor.RedactBody(path, body)
+}
+
+// debugEnabled reports whether debug records are logged, so the request and
+// response dumps are only redacted and formatted when they are needed.
+func (c *Client) debugEnabled(ctx context.Context) bool {
+	return c.StructuredLogger().Enabled(ctx, logger.LevelDebug)
+}
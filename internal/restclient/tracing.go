@@ -0,0 +1,121 @@
+package restclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// TracerName is the instrumentation scope name of every span created by the
+// SDK.
+const TracerName = "github.com/Arubacloud/sdk-go"
+
+// Span attribute keys shared by the SDK instrumentation. HTTP attributes
+// follow the OpenTelemetry semantic conventions.
+const (
+	AttributeHTTPMethod      = attribute.Key("http.request.method")
+	AttributeHTTPStatusCode  = attribute.Key("http.response.status_code")
+	AttributeHTTPResendCount = attribute.Key("http.request.resend_count")
+	AttributeURLFull         = attribute.Key("url.full")
+	AttributeTraceID         = attribute.Key("aruba.error.trace_id")
+)
+
+// WithTracerProvider sets the provider of the tracer used to create a span
+// for every attempt. A nil provider disables tracing, which is the default.
+func WithTracerProvider(provider trace.TracerProvider) ClientOption {
+	return func(c *Client) {
+		if provider != nil {
+			c.tracer = provider.Tracer(TracerName)
+		}
+	}
+}
+
+// Tracer returns the client tracer. It never returns nil: a no-op tracer is
+// returned when tracing is disabled or the client itself is nil.
+func (c *Client) Tracer() trace.Tracer {
+	if c == nil || c.tracer == nil {
+		return noop.NewTracerProvider().Tracer(TracerName)
+	}
+
+	return c.tracer
+}
+
+// startAttempt starts the span of a single attempt. The returned context
+// carries the span, so the request built from it propagates the trace
+// context to the middleware (e.g. a token refresh) and to the server.
+func (c *Client) startAttempt(ctx context.Context, method, url string, attempt int) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		AttributeHTTPMethod.String(method),
+		AttributeURLFull.String(url),
+	}
+	if attempt > 1 {
+		attrs = append(attrs, AttributeHTTPResendCount.Int(attempt-1))
+	}
+
+	return c.Tracer().Start(ctx, "HTTP "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// injectTraceContext writes the W3C traceparent and tracestate headers of
+// the span carried by the request context.
+func injectTraceContext(req *http.Request) {
+	propagation.TraceContext{}.Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// endAttempt records the outcome of an attempt and ends its span.
+func endAttempt(span trace.Span, resp *http.Response, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetAttributes(AttributeHTTPStatusCode.Int(resp.StatusCode))
+	if resp.StatusCode < http.StatusBadRequest {
+		return
+	}
+
+	span.SetStatus(codes.Error, resp.Status)
+	if !span.IsRecording() {
+		return
+	}
+
+	if traceID := errorTraceID(resp); traceID != "" {
+		span.SetAttributes(AttributeTraceID.String(traceID))
+	}
+}
+
+// errorTraceID extracts the server-side trace ID from an error response
+// body, which is rewound afterwards.
+func errorTraceID(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var errResp struct {
+		TraceID string `json:"traceId"`
+	}
+	if json.Unmarshal(body, &errResp) != nil {
+		return ""
+	}
+
+	return errResp.TraceID
+}
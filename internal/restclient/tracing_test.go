@@ -0,0 +1,111 @@
+package restclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
+	"github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+)
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestDoRequest_TracesEveryAttempt(t *testing.T) {
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if len(traceparents) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"title":"Not Found","traceId":"server-trace-1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client := NewClient(server.URL, http.DefaultClient, standard.NewInterceptor(), &noop.NoOpLogger{},
+		WithRetryPolicy(&countingPolicy{maxAttempts: 3}),
+		WithTracerProvider(provider),
+	)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	resp, err := client.DoRequest(ctx, http.MethodGet, "/resource", nil, nil, nil)
+	parent.End()
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("ended spans = %d, want 2 attempts and the parent", len(spans))
+	}
+
+	for i, span := range spans[:2] {
+		if span.Name() != "HTTP GET" {
+			t.Errorf("attempt %d span name = %q, want %q", i+1, span.Name(), "HTTP GET")
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("attempt %d span is not a child of the caller span", i+1)
+		}
+		if status, _ := spanAttribute(span, AttributeHTTPStatusCode); status.AsInt64() == 0 {
+			t.Errorf("attempt %d span has no status code", i+1)
+		}
+		wantParent := "00-" + parent.SpanContext().TraceID().String() + "-" + span.SpanContext().SpanID().String() + "-01"
+		if traceparents[i] != wantParent {
+			t.Errorf("attempt %d traceparent = %q, want %q", i+1, traceparents[i], wantParent)
+		}
+	}
+
+	last := spans[1]
+	if resends, _ := spanAttribute(last, AttributeHTTPResendCount); resends.AsInt64() != 1 {
+		t.Errorf("resend count = %d, want 1", resends.AsInt64())
+	}
+	if traceID, _ := spanAttribute(last, AttributeTraceID); traceID.AsString() != "server-trace-1" {
+		t.Errorf("error trace ID = %q, want %q", traceID.AsString(), "server-trace-1")
+	}
+	if last.Status().Code != codes.Error {
+		t.Errorf("span status = %v, want Error", last.Status().Code)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "server-trace-1") {
+		t.Errorf("response body should be left readable, got %q", body)
+	}
+}
+
+func TestDoRequest_TracingDisabledByDefault(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	t.Cleanup(server.Close)
+
+	resp, err := newTestRestClient(t, server.URL).DoRequest(context.Background(), http.MethodGet, "/resource", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if traceparent != "" {
+		t.Errorf("traceparent = %q, want none without a tracer provider", traceparent)
+	}
+}
@@ -0,0 +1,200 @@
+// Package transport builds the HTTP transport shared by the API client, the
+// OAuth2 provider connector and the Vault credentials repository, so that
+// they all apply the same TLS, proxy and connection pool settings.
+package transport
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ErrPinMismatch is returned, wrapped, when no certificate of the chain
+// presented by a server matches the pinned public keys.
+var ErrPinMismatch = errors.New("no certificate matches the pinned public keys")
+
+const (
+	DefaultMaxIdleConns          = 100
+	DefaultMaxIdleConnsPerHost   = 10
+	DefaultIdleConnTimeout       = 90 * time.Second
+	DefaultDialTimeout           = 10 * time.Second
+	DefaultKeepAlive             = 30 * time.Second
+	DefaultTLSHandshakeTimeout   = 10 * time.Second
+	DefaultResponseHeaderTimeout = 60 * time.Second
+	DefaultMinTLSVersion         = tls.VersionTLS12
+)
+
+// Config describes an HTTP transport. Zero values select the defaults.
+type Config struct {
+	// RootCAs is a PEM bundle of the trusted CA certificates. When set, it
+	// replaces the system roots.
+	RootCAs []byte
+
+	// ClientCertificates are presented to the servers requesting mutual TLS.
+	ClientCertificates []tls.Certificate
+
+	// ProxyURL is the URL of an HTTP, HTTPS or SOCKS5 proxy. When nil, the
+	// proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+	// variables.
+	ProxyURL *url.URL
+
+	// NoProxy lists the hosts reached without ProxyURL, in the NO_PROXY
+	// format (e.g. "example.com", ".example.com", "10.0.0.0/8").
+	NoProxy []string
+
+	// PinnedPublicKeys are the SHA-256 hashes of the SubjectPublicKeyInfo
+	// of trusted certificates. When set, a certificate of the verified chain
+	// must match one of them.
+	PinnedPublicKeys [][sha256.Size]byte
+
+	// MinTLSVersion is the minimum TLS version accepted, e.g.
+	// tls.VersionTLS13.
+	MinTLSVersion uint16
+
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+
+	DialTimeout           time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration
+}
+
+// New creates an HTTP transport from the configuration.
+func New(cfg Config) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:   orDefault(cfg.MinTLSVersion, DefaultMinTLSVersion),
+		Certificates: cfg.ClientCertificates,
+	}
+
+	if len(cfg.RootCAs) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.RootCAs) {
+			return nil, errors.New("no CA certificate found in the PEM bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(cfg.PinnedPublicKeys) > 0 {
+		tlsConfig.VerifyConnection = verifyPins(cfg.PinnedPublicKeys)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   orDefault(cfg.DialTimeout, DefaultDialTimeout),
+		KeepAlive: DefaultKeepAlive,
+	}
+
+	return &http.Transport{
+		Proxy:                 proxyFunc(cfg.ProxyURL, cfg.NoProxy),
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          orDefault(cfg.MaxIdleConns, DefaultMaxIdleConns),
+		MaxIdleConnsPerHost:   orDefault(cfg.MaxIdleConnsPerHost, DefaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       cfg.MaxConnsPerHost,
+		IdleConnTimeout:       orDefault(cfg.IdleConnTimeout, DefaultIdleConnTimeout),
+		TLSHandshakeTimeout:   orDefault(cfg.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: orDefault(cfg.ResponseHeaderTimeout, DefaultResponseHeaderTimeout),
+		ExpectContinueTimeout: time.Second,
+	}, nil
+}
+
+// ParsePin decodes a public key pin: the base64-encoded SHA-256 hash of a
+// SubjectPublicKeyInfo, optionally prefixed with "sha256/".
+func ParsePin(pin string) ([sha256.Size]byte, error) {
+	var hash [sha256.Size]byte
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(strings.TrimSpace(pin), "sha256/"))
+	if err != nil {
+		return hash, fmt.Errorf("invalid public key pin %q: %w", pin, err)
+	}
+	if len(decoded) != sha256.Size {
+		return hash, fmt.Errorf("invalid public key pin %q: not a SHA-256 hash", pin)
+	}
+
+	copy(hash[:], decoded)
+	return hash, nil
+}
+
+// Pin returns the pin of the public key of a certificate, in the format
+// accepted by ParsePin.
+func Pin(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256/" + base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// ParseProxyURL parses the URL of an HTTP, HTTPS or SOCKS5 proxy.
+func ParseProxyURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("proxy URL is malformed: %w", err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("proxy URL has invalid scheme '%s': must be http, https, socks5 or socks5h", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, errors.New("proxy URL is missing a host")
+	}
+
+	return u, nil
+}
+
+// proxyFunc returns the proxy selection function of the transport.
+func proxyFunc(proxyURL *url.URL, noProxy []string) func(*http.Request) (*url.URL, error) {
+	if proxyURL == nil {
+		return http.ProxyFromEnvironment
+	}
+
+	proxy := (&httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    strings.Join(noProxy, ","),
+	}).ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// verifyPins returns a connection check requiring a certificate of the
+// verified chain to match one of the pins.
+func verifyPins(pins [][sha256.Size]byte) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		for _, chain := range cs.VerifiedChains {
+			for _, cert := range chain {
+				hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+				for _, pin := range pins {
+					if subtle.ConstantTimeCompare(hash[:], pin[:]) == 1 {
+						return nil
+					}
+				}
+			}
+		}
+
+		return fmt.Errorf("%w: %s", ErrPinMismatch, cs.ServerName)
+	}
+}
+
+func orDefault[T comparable](value, def T) T {
+	var zero T
+	if value == zero {
+		return def
+	}
+	return value
+}
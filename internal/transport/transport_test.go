@@ -0,0 +1,226 @@
+package transport
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// newTLSServer starts a TLS server and returns it with the PEM encoding of
+// its certificate.
+func newTLSServer(t *testing.T, configure func(*tls.Config)) (*httptest.Server, []byte) {
+	t.Helper()
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			w.Header().Set("X-Client-CN", r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.TLS = &tls.Config{}
+	if configure != nil {
+		configure(srv.TLS)
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+}
+
+func get(t *testing.T, cfg Config, rawURL string) (*http.Response, error) {
+	t.Helper()
+
+	transport, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	t.Cleanup(transport.CloseIdleConnections)
+
+	resp, err := (&http.Client{Transport: transport}).Get(rawURL)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestNew_RootCAs(t *testing.T) {
+	srv, certPEM := newTLSServer(t, nil)
+
+	if _, err := get(t, Config{}, srv.URL); err == nil {
+		t.Error("GET with the system roots succeeded, want an unknown authority error")
+	}
+	if _, err := get(t, Config{RootCAs: certPEM}, srv.URL); err != nil {
+		t.Errorf("GET with the server CA error = %v", err)
+	}
+
+	if _, err := New(Config{RootCAs: []byte("not a certificate")}); err == nil {
+		t.Error("New() with an invalid bundle succeeded, want an error")
+	}
+}
+
+func TestNew_PinnedPublicKeys(t *testing.T) {
+	srv, certPEM := newTLSServer(t, nil)
+
+	pin, err := ParsePin(Pin(srv.Certificate()))
+	if err != nil {
+		t.Fatalf("ParsePin() error = %v", err)
+	}
+	if _, err := get(t, Config{RootCAs: certPEM, PinnedPublicKeys: [][sha256.Size]byte{pin}}, srv.URL); err != nil {
+		t.Errorf("GET with a matching pin error = %v", err)
+	}
+
+	other := sha256.Sum256([]byte("another key"))
+	if _, err := get(t, Config{RootCAs: certPEM, PinnedPublicKeys: [][sha256.Size]byte{other}}, srv.URL); !errors.Is(err, ErrPinMismatch) {
+		t.Errorf("GET with a wrong pin error = %v, want ErrPinMismatch", err)
+	}
+}
+
+func TestNew_ClientCertificates(t *testing.T) {
+	srv, certPEM := newTLSServer(t, func(cfg *tls.Config) {
+		cfg.ClientAuth = tls.RequireAnyClientCert
+	})
+
+	if _, err := get(t, Config{RootCAs: certPEM}, srv.URL); err == nil {
+		t.Error("GET without a client certificate succeeded, want a handshake error")
+	}
+
+	resp, err := get(t, Config{RootCAs: certPEM, ClientCertificates: []tls.Certificate{newClientCertificate(t, "sdk-client")}}, srv.URL)
+	if err != nil {
+		t.Fatalf("GET with a client certificate error = %v", err)
+	}
+	if got := resp.Header.Get("X-Client-CN"); got != "sdk-client" {
+		t.Errorf("client certificate CN = %q, want sdk-client", got)
+	}
+}
+
+func TestNew_MinTLSVersion(t *testing.T) {
+	srv, certPEM := newTLSServer(t, func(cfg *tls.Config) {
+		cfg.MaxVersion = tls.VersionTLS12
+	})
+
+	if _, err := get(t, Config{RootCAs: certPEM}, srv.URL); err != nil {
+		t.Errorf("GET with the default minimum version error = %v", err)
+	}
+	if _, err := get(t, Config{RootCAs: certPEM, MinTLSVersion: tls.VersionTLS13}, srv.URL); err == nil {
+		t.Error("GET requiring TLS 1.3 from a TLS 1.2 server succeeded, want a handshake error")
+	}
+}
+
+func TestNew_Proxy(t *testing.T) {
+	proxyURL, err := ParseProxyURL("socks5://proxy.internal:1080")
+	if err != nil {
+		t.Fatalf("ParseProxyURL() error = %v", err)
+	}
+
+	transport, err := New(Config{ProxyURL: proxyURL, NoProxy: []string{"vault.internal", ".corp"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	for rawURL, want := range map[string]string{
+		"https://api.arubacloud.com/projects": "socks5://proxy.internal:1080",
+		"https://vault.internal:8200/v1":      "",
+		"https://idp.corp/token":              "",
+	} {
+		req := &http.Request{URL: mustParse(t, rawURL)}
+		got, err := transport.Proxy(req)
+		if err != nil {
+			t.Fatalf("Proxy(%s) error = %v", rawURL, err)
+		}
+		if (got == nil && want != "") || (got != nil && got.String() != want) {
+			t.Errorf("Proxy(%s) = %v, want %q", rawURL, got, want)
+		}
+	}
+
+	for _, rawURL := range []string{"ftp://proxy:21", "proxy.internal:3128", "http://"} {
+		if _, err := ParseProxyURL(rawURL); err == nil {
+			t.Errorf("ParseProxyURL(%q) succeeded, want an error", rawURL)
+		}
+	}
+}
+
+func TestNew_Defaults(t *testing.T) {
+	transport, err := New(Config{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost || transport.IdleConnTimeout != DefaultIdleConnTimeout ||
+		transport.ResponseHeaderTimeout != DefaultResponseHeaderTimeout || transport.TLSClientConfig.MinVersion != DefaultMinTLSVersion {
+		t.Errorf("transport = %+v, want the defaults", transport)
+	}
+
+	transport, err = New(Config{MaxIdleConnsPerHost: 32, ResponseHeaderTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if transport.MaxIdleConnsPerHost != 32 || transport.ResponseHeaderTimeout != 5*time.Second {
+		t.Errorf("transport = %+v, want the configured values", transport)
+	}
+}
+
+func TestParsePin(t *testing.T) {
+	hash := sha256.Sum256([]byte("key"))
+	pin := "sha256/LHDhK3oGRvkiefQnx7OOczTY5Tic/xZ6HcMOc/gmtoM="
+
+	for _, in := range []string{pin, pin[len("sha256/"):]} {
+		got, err := ParsePin(in)
+		if err != nil || got != hash {
+			t.Errorf("ParsePin(%q) = %x, %v, want %x", in, got, err, hash)
+		}
+	}
+
+	for _, in := range []string{"sha256/not-base64!", "c2hvcnQ="} {
+		if _, err := ParsePin(in); err == nil {
+			t.Errorf("ParsePin(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func newClientCertificate(t *testing.T, commonName string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func mustParse(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) error = %v", rawURL, err)
+	}
+	return u
+}
@@ -2,27 +2,49 @@
 package aruba
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	redis_client "github.com/redis/go-redis/v9"
 
+	chain_creds_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/credentialsrepository/chain"
+	env_creds_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/credentialsrepository/env"
+	file_creds_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/credentialsrepository/file"
 	memory_creds_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/credentialsrepository/memory"
+	profile_creds_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/credentialsrepository/profile"
 	vault_creds_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/credentialsrepository/vault"
 	oauth2_connector "github.com/Arubacloud/sdk-go/internal/impl/auth/providerconnector/oauth2"
 	std_token_manager "github.com/Arubacloud/sdk-go/internal/impl/auth/tokenmanager/standard"
 	file_token_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/tokenrepository/file"
+	keyed_token_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/tokenrepository/keyed"
 	memory_token_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/tokenrepository/memory"
 	redis_token_repo "github.com/Arubacloud/sdk-go/internal/impl/auth/tokenrepository/redis"
+	lru_cache "github.com/Arubacloud/sdk-go/internal/impl/cache/lru"
+	consecutive_breaker "github.com/Arubacloud/sdk-go/internal/impl/circuitbreaker/consecutive"
 	std_interceptor "github.com/Arubacloud/sdk-go/internal/impl/interceptor/standard"
 	native_logger "github.com/Arubacloud/sdk-go/internal/impl/logger/native"
 	noop_logger "github.com/Arubacloud/sdk-go/internal/impl/logger/noop"
+	tokenbucket_ratelimit "github.com/Arubacloud/sdk-go/internal/impl/ratelimit/tokenbucket"
+	std_redactor "github.com/Arubacloud/sdk-go/internal/impl/redact/standard"
+	backoff_retry "github.com/Arubacloud/sdk-go/internal/impl/retry/backoff"
 	"github.com/Arubacloud/sdk-go/internal/ports/auth"
+	"github.com/Arubacloud/sdk-go/internal/ports/cache"
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
 	"github.com/Arubacloud/sdk-go/internal/ports/interceptor"
 	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+	"github.com/Arubacloud/sdk-go/internal/ports/ratelimit"
+	"github.com/Arubacloud/sdk-go/internal/ports/redact"
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
+	"github.com/Arubacloud/sdk-go/internal/profile"
 	"github.com/Arubacloud/sdk-go/internal/restclient"
+	std_transport "github.com/Arubacloud/sdk-go/internal/transport"
 	middleware_util "github.com/Arubacloud/sdk-go/pkg/util/middleware"
 )
 
@@ -34,7 +56,7 @@ func buildClient(options *Options) (Client, error) {
 		return nil, err // TODO: better error handling
 	}
 
-	restClient, err := buildRESTClient(options)
+	restClient, tokenManager, err := buildRESTClient(options)
 	if err != nil {
 		return nil, err // TODO: better error handling
 	}
@@ -89,6 +111,10 @@ func buildClient(options *Options) (Client, error) {
 		return nil, err // TODO: better error handling
 	}
 
+	// The token manager only renews tokens in the background once the client
+	// is fully built.
+	tokenManager.Start(context.Background())
+
 	return &clientImpl{
 		auditClient:     auditClient,
 		computeClient:   computeClient,
@@ -100,37 +126,266 @@ func buildClient(options *Options) (Client, error) {
 		scheduleClient:  scheduleClient,
 		securityClient:  securityClient,
 		storageClient:   storageClient,
+		rest:            restClient,
+		tokenManager:    tokenManager,
 	}, nil
 }
 
 //
 // Dependencies
 
-func buildRESTClient(options *Options) (*restclient.Client, error) {
-	httpClient, err := buildHTTPClient(options)
+// buildRESTClient also returns the token manager, whose background refresh
+// is started, if enabled, and stopped by Client.Close.
+func buildRESTClient(options *Options) (*restclient.Client, *std_token_manager.TokenManager, error) {
+	transport, err := buildTransport(options)
 	if err != nil {
-		return nil, err // TODO: better error handling
+		return nil, nil, err // TODO: better error handling
+	}
+
+	httpClient, err := buildHTTPClient(options, transport)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
 	}
 
 	logger, err := buildLogger(options)
 	if err != nil {
-		return nil, err // TODO: better error handling
+		return nil, nil, err // TODO: better error handling
 	}
 
-	middleware, err := buildMiddleware(options)
+	middleware, tokenManager, err := buildMiddleware(options, logger, buildAuthTransport(options, transport))
 	if err != nil {
-		return nil, err // TODO: better error handling
+		return nil, nil, err // TODO: better error handling
+	}
+
+	retryPolicy, err := buildRetryPolicy(options)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
 	}
 
-	return restclient.NewClient(options.baseURL, httpClient, middleware, logger), nil
+	rateLimiter, err := buildRateLimiter(options)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
+	}
+
+	circuitBreaker, err := buildCircuitBreaker(options)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
+	}
+
+	responseCache, err := buildResponseCache(options)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
+	}
+
+	redactor, err := buildRedactor(options)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
+	}
+
+	newIdempotencyKey, err := buildIdempotencyKeyGenerator(options)
+	if err != nil {
+		return nil, nil, err // TODO: better error handling
+	}
+
+	return restclient.NewClient(
+		options.baseURL,
+		httpClient,
+		middleware,
+		logger,
+		restclient.WithRetryPolicy(retryPolicy),
+		restclient.WithRateLimiter(rateLimiter),
+		restclient.WithTracerProvider(options.userDefinedDependencies.tracerProvider),
+		restclient.WithClientTrace(options.userDefinedDependencies.clientTrace),
+		restclient.WithCircuitBreaker(circuitBreaker),
+		restclient.WithResponseCache(responseCache),
+		restclient.WithIdempotencyKeys(newIdempotencyKey),
+		restclient.WithDryRun(options.dryRun),
+		restclient.WithRedactor(redactor),
+		restclient.WithCompression(buildCompression(options)),
+	), tokenManager, nil
 }
 
-func buildHTTPClient(options *Options) (*http.Client, error) {
+func buildCompression(options *Options) *restclient.Compression {
+	if options.compression == nil {
+		return nil
+	}
+
+	return &restclient.Compression{MinRequestSize: options.compression.minRequestSize}
+}
+
+func buildHTTPClient(options *Options, transport *http.Transport) (*http.Client, error) {
 	if options.userDefinedDependencies.httpClient != nil {
 		return options.userDefinedDependencies.httpClient, nil
 	}
 
-	return http.DefaultClient, nil
+	return &http.Client{Transport: transport}, nil
+}
+
+// buildAuthTransport returns the transport of the OAuth2 connector and the
+// Vault client: the one of the user-defined HTTP client, if any, so that its
+// proxy, TLS settings or middleware apply to them as well, and the shared
+// transport otherwise.
+func buildAuthTransport(options *Options, transport *http.Transport) http.RoundTripper {
+	httpClient := options.userDefinedDependencies.httpClient
+	if httpClient == nil {
+		return transport
+	}
+
+	if httpClient.Transport == nil {
+		return http.DefaultTransport
+	}
+
+	return httpClient.Transport
+}
+
+// buildTransport builds the transport shared by the API client, the OAuth2
+// connector and the Vault client.
+func buildTransport(options *Options) (*http.Transport, error) {
+	t := options.transport
+	if t == nil {
+		return std_transport.New(std_transport.Config{})
+	}
+
+	cfg := std_transport.Config{
+		RootCAs:               t.rootCAs,
+		NoProxy:               t.noProxy,
+		MinTLSVersion:         t.minTLSVersion,
+		MaxIdleConnsPerHost:   t.maxIdleConnsPerHost,
+		MaxConnsPerHost:       t.maxConnsPerHost,
+		IdleConnTimeout:       t.idleConnTimeout,
+		DialTimeout:           t.dialTimeout,
+		TLSHandshakeTimeout:   t.tlsHandshakeTimeout,
+		ResponseHeaderTimeout: t.responseHeaderTimeout,
+	}
+
+	if t.rootCAsFile != "" {
+		rootCAs, err := os.ReadFile(t.rootCAsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the root CAs: %w", err)
+		}
+		cfg.RootCAs = rootCAs
+	}
+
+	switch {
+	case t.clientCertificateFile != "":
+		cert, err := tls.LoadX509KeyPair(t.clientCertificateFile, t.clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		cfg.ClientCertificates = []tls.Certificate{cert}
+
+	case t.clientCertificate != nil:
+		cert, err := tls.X509KeyPair(t.clientCertificate, t.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the client certificate: %w", err)
+		}
+		cfg.ClientCertificates = []tls.Certificate{cert}
+	}
+
+	if t.proxyURL != "" {
+		proxyURL, err := std_transport.ParseProxyURL(t.proxyURL)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ProxyURL = proxyURL
+	}
+
+	for _, pin := range t.pinnedPublicKeys {
+		hash, err := std_transport.ParsePin(pin)
+		if err != nil {
+			return nil, err
+		}
+		cfg.PinnedPublicKeys = append(cfg.PinnedPublicKeys, hash)
+	}
+
+	return std_transport.New(cfg)
+}
+
+func buildRetryPolicy(options *Options) (retry.Policy, error) {
+	if options.userDefinedDependencies.retryPolicy != nil {
+		return options.userDefinedDependencies.retryPolicy, nil
+	}
+
+	if options.retryPolicy == nil {
+		return nil, nil
+	}
+
+	if options.retryPolicy.retryNonIdempotent {
+		return backoff_retry.NewPolicyWithNonIdempotentRetries(
+			options.retryPolicy.maxAttempts,
+			options.retryPolicy.baseDelay,
+			options.retryPolicy.maxDelay,
+		), nil
+	}
+
+	return backoff_retry.NewPolicy(
+		options.retryPolicy.maxAttempts,
+		options.retryPolicy.baseDelay,
+		options.retryPolicy.maxDelay,
+	), nil
+}
+
+func buildIdempotencyKeyGenerator(options *Options) (func() string, error) {
+	if !options.idempotencyKeys {
+		return nil, nil
+	}
+
+	return rand.Text, nil
+}
+
+func buildRateLimiter(options *Options) (ratelimit.Limiter, error) {
+	if options.userDefinedDependencies.rateLimiter != nil {
+		return options.userDefinedDependencies.rateLimiter, nil
+	}
+
+	if options.rateLimit == nil {
+		return nil, nil
+	}
+
+	return tokenbucket_ratelimit.NewLimiter(
+		options.rateLimit.readsPerSecond,
+		options.rateLimit.readBurst,
+		options.rateLimit.writesPerSecond,
+		options.rateLimit.writeBurst,
+		options.rateLimit.maxInFlight,
+	), nil
+}
+
+func buildCircuitBreaker(options *Options) (circuitbreaker.Breaker, error) {
+	if options.userDefinedDependencies.circuitBreaker != nil {
+		return options.userDefinedDependencies.circuitBreaker, nil
+	}
+
+	if options.circuitBreaker == nil {
+		return nil, nil
+	}
+
+	return consecutive_breaker.NewBreaker(
+		options.circuitBreaker.failureThreshold,
+		options.circuitBreaker.coolDown,
+		options.circuitBreaker.halfOpenProbes,
+	), nil
+}
+
+func buildResponseCache(options *Options) (cache.Cache, error) {
+	if options.userDefinedDependencies.responseCache != nil {
+		return options.userDefinedDependencies.responseCache, nil
+	}
+
+	if options.responseCache == nil {
+		return nil, nil
+	}
+
+	return lru_cache.NewCache(options.responseCache.maxEntries), nil
+}
+
+func buildRedactor(options *Options) (redact.Redactor, error) {
+	rules := std_redactor.DefaultRules()
+	if len(options.redaction.jsonPaths) > 0 {
+		rules = append(rules, std_redactor.Rule{Fields: options.redaction.jsonPaths})
+	}
+
+	return std_redactor.NewRedactor(rules, append(std_redactor.DefaultHeaders(), options.redaction.headers...)), nil
 }
 
 func buildLogger(options *Options) (logger.Logger, error) {
@@ -148,11 +403,11 @@ func buildLogger(options *Options) (logger.Logger, error) {
 	return nil, fmt.Errorf("unknown logging type: %d", options.loggerType)
 }
 
-func buildMiddleware(options *Options) (interceptor.Interceptor, error) {
+func buildMiddleware(options *Options, logger logger.Logger, transport http.RoundTripper) (interceptor.Interceptor, *std_token_manager.TokenManager, error) {
 	// The token manager must be always the last to be bound
-	tokenManager, err := buildTokenManager(&options.tokenManager)
+	tokenManager, err := buildTokenManager(&options.tokenManager, &options.userDefinedDependencies, logger, transport)
 	if err != nil {
-		return nil, err // TODO: better error handling
+		return nil, nil, err // TODO: better error handling
 	}
 
 	ua := options.userAgent
@@ -160,32 +415,53 @@ func buildMiddleware(options *Options) (interceptor.Interceptor, error) {
 		ua = defaultUserAgent
 	}
 
-	if options.userDefinedDependencies.middleware != nil {
+	userDefinedMiddleware := options.userDefinedDependencies.middleware
+
+	if isInterceptable(userDefinedMiddleware) {
 		// Bind UA first so user middleware (and the token manager last) can still override it.
-		interceptable, ok := options.userDefinedDependencies.middleware.(interceptor.Interceptable)
-		if !ok {
-			return nil, errors.New("failed to bind the token manager to the user-defined middleware")
-		}
+		interceptable := userDefinedMiddleware.(interceptor.Interceptable)
 		if err := interceptable.Bind(middleware_util.WithUserAgent(ua)); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		middleware, err := buildUserDefinedMiddleware(options.userDefinedDependencies.middleware, tokenManager)
+		middleware, err := buildUserDefinedMiddleware(userDefinedMiddleware.(interceptor.Interceptor), tokenManager)
 		if err != nil {
-			return nil, err // TODO: better error handling
+			return nil, nil, err // TODO: better error handling
 		}
-		return middleware, nil
+		return middleware, tokenManager, nil
+	}
+
+	roundTripper, isRoundTripper := userDefinedMiddleware.(interceptor.RoundTripper)
+	if userDefinedMiddleware != nil && !isRoundTripper {
+		return nil, nil, errors.New("failed to bind the token manager to the user-defined middleware")
 	}
 
 	middleware := std_interceptor.NewInterceptor()
 	if err := middleware.Bind(middleware_util.WithUserAgent(ua)); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+
+	// A round-trip only middleware is wrapped by the standard interceptor,
+	// between the User-Agent and the token manager.
+	if isRoundTripper {
+		if err := middleware.BindMiddleware(roundTripper.RoundTrip); err != nil {
+			return nil, nil, err // TODO: better error handling
+		}
+	}
+
 	err = tokenManager.BindTo(middleware)
 	if err != nil {
-		return nil, err // TODO: better error handling
+		return nil, nil, err // TODO: better error handling
 	}
 
-	return middleware, nil
+	return middleware, tokenManager, nil
+}
+
+// isInterceptable reports whether the user-defined middleware is an
+// interceptor the SDK can bind its own functions to.
+func isInterceptable(middleware Middleware) bool {
+	_, isInterceptor := middleware.(interceptor.Interceptor)
+	_, isInterceptable := middleware.(interceptor.Interceptable)
+	return isInterceptor && isInterceptable
 }
 
 func buildUserDefinedMiddleware(middleware interceptor.Interceptor, tokenManager auth.TokenManager) (interceptor.Interceptor, error) {
@@ -206,38 +482,127 @@ func buildUserDefinedMiddleware(middleware interceptor.Interceptor, tokenManager
 //
 // Token Manager
 
-func buildTokenManager(options *tokenManagerOptions) (*std_token_manager.TokenManager, error) {
+func buildTokenManager(options *tokenManagerOptions, dependencies *userDefinedDependenciesOptions, logger logger.Logger, transport http.RoundTripper) (*std_token_manager.TokenManager, error) {
 	if options.token != nil {
 		return std_token_manager.NewStaticTokenManager(
 			memory_token_repo.NewTokenRepositoryWithAccessToken(*options.token),
+			std_token_manager.WithClientTrace(dependencies.clientTrace),
+			std_token_manager.WithLogger(logger),
 		), nil
 	}
 
-	providerConnector, err := buildProviderConnector(options.tokenIssuerOptions)
+	// The credentials chain is shared with the token repository, which is
+	// keyed by the client ID it resolves.
+	var credentialsRepository auth.CredentialsRepository
+	if options.tokenIssuerOptions.credentialsChainOptions != nil {
+		var err error
+		credentialsRepository, err = buildCredentialsChain(options.tokenIssuerOptions, transport)
+		if err != nil {
+			return nil, err // TODO: better error handling
+		}
+	}
+
+	tokenRepository, err := buildTokenRepository(options.tokenIssuerOptions, credentialsRepository)
 	if err != nil {
 		return nil, err // TODO: better error handling
 	}
 
-	tokenRepository, err := buildTokenRepository(options.tokenIssuerOptions)
+	providerConnector, err := buildProviderConnector(options.tokenIssuerOptions, tokenRepository, credentialsRepository, transport)
 	if err != nil {
 		return nil, err // TODO: better error handling
 	}
 
-	tokenManager := std_token_manager.NewTokenManager(providerConnector, tokenRepository)
+	tokenManagerOptions := []std_token_manager.Option{
+		std_token_manager.WithTracerProvider(dependencies.tracerProvider),
+		std_token_manager.WithClientTrace(dependencies.clientTrace),
+		std_token_manager.WithLogger(logger),
+	}
+
+	if refresh := options.tokenIssuerOptions.backgroundRefreshOptions; refresh != nil {
+		tokenManagerOptions = append(
+			tokenManagerOptions,
+			std_token_manager.WithBackgroundRefresh(refresh.renewBefore, refresh.minBackoff, refresh.maxBackoff),
+		)
+	}
+
+	tokenManager := std_token_manager.NewTokenManager(providerConnector, tokenRepository, tokenManagerOptions...)
 
 	return tokenManager, nil
 }
 
-func buildProviderConnector(options *tokenIssuerOptions) (*oauth2_connector.ProviderConnector, error) {
-	credentialsRepository, err := buildCredentialsRepository(options)
-	if err != nil {
-		return nil, err // TODO: better error handling
+func buildProviderConnector(options *tokenIssuerOptions, tokenRepository auth.TokenRepository, credentialsRepository auth.CredentialsRepository, transport http.RoundTripper) (auth.ProviderConnector, error) {
+	httpClientOption := oauth2_connector.WithHTTPClient(&http.Client{Transport: transport})
+
+	if options.interactiveGrantOptions != nil {
+		return buildInteractiveProviderConnector(options, tokenRepository, httpClientOption)
+	}
+
+	if options.clientAssertionOptions != nil {
+		return oauth2_connector.NewClientAssertionConnector(
+			buildClientAssertionCredentialsRepository(options.clientAssertionOptions),
+			options.issuerURL,
+			options.scopes,
+			httpClientOption,
+		), nil
+	}
+
+	if credentialsRepository == nil {
+		var err error
+		credentialsRepository, err = buildCredentialsRepository(options, transport)
+		if err != nil {
+			return nil, err // TODO: better error handling
+		}
 	}
 
-	return oauth2_connector.NewProviderConnector(credentialsRepository, options.issuerURL, options.scopes), nil
+	return oauth2_connector.NewProviderConnector(
+		credentialsRepository,
+		options.issuerURL,
+		options.scopes,
+		httpClientOption,
+	), nil
+}
+
+func buildInteractiveProviderConnector(options *tokenIssuerOptions, tokenRepository auth.TokenRepository, opts ...oauth2_connector.Option) (auth.ProviderConnector, error) {
+	grant := options.interactiveGrantOptions
+	authorizationURL, deviceAuthorizationURL := grant.endpoints(options.issuerURL)
+
+	switch grant.grant {
+	case deviceAuthorizationGrant:
+		if deviceAuthorizationURL == "" {
+			return nil, errors.New("no device authorization endpoint defined")
+		}
+
+		return oauth2_connector.NewDeviceConnector(
+			grant.clientID,
+			deviceAuthorizationURL,
+			options.issuerURL,
+			options.scopes,
+			grant.devicePrompt,
+			tokenRepository,
+			opts...,
+		), nil
+
+	case authorizationCodeGrant:
+		if authorizationURL == "" {
+			return nil, errors.New("no authorization endpoint defined")
+		}
+
+		return oauth2_connector.NewAuthCodeConnector(
+			grant.clientID,
+			authorizationURL,
+			options.issuerURL,
+			options.scopes,
+			grant.listenAddress,
+			grant.openURL,
+			tokenRepository,
+			opts...,
+		), nil
+	}
+
+	return nil, errors.New("unknown interactive grant")
 }
 
-func buildCredentialsRepository(options *tokenIssuerOptions) (auth.CredentialsRepository, error) {
+func buildCredentialsRepository(options *tokenIssuerOptions, transport http.RoundTripper) (auth.CredentialsRepository, error) {
 	if options.clientCredentialOptions != nil {
 		return memory_creds_repo.NewCredentialsRepository(
 			options.clientCredentialOptions.clientID,
@@ -246,7 +611,7 @@ func buildCredentialsRepository(options *tokenIssuerOptions) (auth.CredentialsRe
 	}
 
 	if options.vaultCredentialsRepositoryOptions != nil {
-		vaultCredentialsRepository, err := buildVaultCredentialsRepository(options.vaultCredentialsRepositoryOptions)
+		vaultCredentialsRepository, err := buildVaultCredentialsRepository(options.vaultCredentialsRepositoryOptions, transport)
 		if err != nil {
 			return nil, err // TODO: better error handling
 		}
@@ -257,10 +622,48 @@ func buildCredentialsRepository(options *tokenIssuerOptions) (auth.CredentialsRe
 	return nil, errors.New("no credentials repository defined")
 }
 
-func buildVaultCredentialsRepository(options *vaultCredentialsRepositoryOptions) (*vault_creds_repo.CredentialsRepository, error) {
+func buildCredentialsChain(options *tokenIssuerOptions, transport http.RoundTripper) (auth.CredentialsRepository, error) {
+	repositories := []auth.CredentialsRepository{env_creds_repo.NewCredentialsRepository()}
+
+	// Without a home directory, there is no profiles file to read.
+	if path, err := profile.DefaultPath(); err == nil {
+		repositories = append(
+			repositories,
+			profile_creds_repo.NewCredentialsRepository(path, profile.ResolveName(options.credentialsChainOptions.profile)),
+		)
+	}
+
+	if options.vaultCredentialsRepositoryOptions != nil {
+		vaultCredentialsRepository, err := buildVaultCredentialsRepository(options.vaultCredentialsRepositoryOptions, transport)
+		if err != nil {
+			return nil, err // TODO: better error handling
+		}
+
+		repositories = append(repositories, vaultCredentialsRepository)
+	}
+
+	return memory_creds_repo.NewCredentialsProxy(chain_creds_repo.NewCredentialsChain(repositories...)), nil
+}
+
+func buildClientAssertionCredentialsRepository(options *clientAssertionOptions) auth.CredentialsRepository {
+	if options.signer != nil {
+		return memory_creds_repo.NewCredentialsRepositoryWithSigner(options.clientID, options.signer, options.keyID)
+	}
+
+	// Not cached in memory, so that the key can be rotated.
+	return file_creds_repo.NewCredentialsRepository(options.clientID, options.keyFile, options.keyID)
+}
+
+func buildVaultCredentialsRepository(options *vaultCredentialsRepositoryOptions, transport http.RoundTripper) (*vault_creds_repo.CredentialsRepository, error) {
 	cfg := vaultapi.DefaultConfig()
 	cfg.Address = options.vaultURI
 
+	// Keep the timeout and redirect handling of the Vault client, which
+	// mutates its HTTP client, on top of the given transport.
+	httpClient := *cfg.HttpClient
+	httpClient.Transport = transport
+	cfg.HttpClient = &httpClient
+
 	client, err := vaultapi.NewClient(cfg)
 	if err != nil {
 		return nil, err // TODO: better error handling
@@ -277,57 +680,70 @@ func buildVaultCredentialsRepository(options *vaultCredentialsRepositoryOptions)
 	), nil
 }
 
-func buildTokenRepository(options *tokenIssuerOptions) (auth.TokenRepository, error) {
+func buildTokenRepository(options *tokenIssuerOptions, credentialsRepository auth.CredentialsRepository) (auth.TokenRepository, error) {
 	var keyIDComponent string
 	if options.clientCredentialOptions != nil {
 		keyIDComponent = options.clientCredentialOptions.clientID
 	} else if options.vaultCredentialsRepositoryOptions != nil {
 		keyIDComponent = options.vaultCredentialsRepositoryOptions.secretID
+	} else if options.clientAssertionOptions != nil {
+		keyIDComponent = options.clientAssertionOptions.clientID
+	} else if options.interactiveGrantOptions != nil {
+		keyIDComponent = options.interactiveGrantOptions.clientID
 	}
 
-	var persistentTokenRepository auth.TokenRepository
-	var err error
+	var newPersistentTokenRepository func(keyIDComponent string) auth.TokenRepository
 
 	if options.redisTokenRepositoryOptions != nil {
-		persistentTokenRepository, err = buildRedisTokenRepository(
-			keyIDComponent,
-			options.redisTokenRepositoryOptions,
-		)
+		adapter, err := buildRedisAdapter(options.redisTokenRepositoryOptions)
 		if err != nil {
 			return nil, err // TODO: better error handling
 		}
 
+		newPersistentTokenRepository = func(keyIDComponent string) auth.TokenRepository {
+			return redis_token_repo.NewRedisTokenRepository(keyIDComponent, adapter)
+		}
+
 	} else if options.fileTokenRepositoryOptions != nil {
-		persistentTokenRepository, err = buildFileTokenRepository(
-			keyIDComponent,
-			options.fileTokenRepositoryOptions,
-		)
-		if err != nil {
-			return nil, err // TODO: better error handling
+		newPersistentTokenRepository = func(keyIDComponent string) auth.TokenRepository {
+			return file_token_repo.NewFileTokenRepository(keyIDComponent, options.fileTokenRepositoryOptions.baseDir)
 		}
 	}
 
-	if persistentTokenRepository != nil {
-		return memory_token_repo.NewTokenProxy(persistentTokenRepository), nil
+	if newPersistentTokenRepository == nil {
+		return memory_token_repo.NewTokenRepository(), nil
 	}
 
-	return memory_token_repo.NewTokenRepository(), nil
+	// The client ID of the credentials chain is only known once one of its
+	// sources has resolved the credentials.
+	if options.credentialsChainOptions != nil {
+		return memory_token_repo.NewTokenProxy(
+			keyed_token_repo.NewTokenRepository(credentialsRepository, func(clientID string) auth.TokenRepository {
+				return newPersistentTokenRepository(chainTokenKey(clientID, options.issuerURL))
+			}),
+		), nil
+	}
+
+	return memory_token_repo.NewTokenProxy(newPersistentTokenRepository(keyIDComponent)), nil
+}
+
+// chainTokenKey identifies the token of a client ID resolved by the
+// credentials chain. The token endpoint is part of it, as profiles may use the
+// same client ID with several of them.
+func chainTokenKey(clientID, issuerURL string) string {
+	sum := sha256.Sum256([]byte(issuerURL))
+	return fmt.Sprintf("%s-%x", clientID, sum[:4])
 }
 
-func buildRedisTokenRepository(clientID string, options *redisTokenRepositoryOptions) (*redis_token_repo.TokenRepository, error) {
+func buildRedisAdapter(options *redisTokenRepositoryOptions) (*redis_token_repo.RedisAdapter, error) {
 	opt, err := redis_client.ParseURL(options.redisURI)
 	if err != nil {
 		return nil, err // TODO: better error handling
 	}
 
 	rdb := redis_client.NewClient(opt)
-	adapter := redis_token_repo.NewRedisAdapter(rdb)
-
-	return redis_token_repo.NewRedisTokenRepository(clientID, adapter), nil
-}
 
-func buildFileTokenRepository(clientID string, options *fileTokenRepositoryOptions) (*file_token_repo.TokenRepository, error) {
-	return file_token_repo.NewFileTokenRepository(clientID, options.baseDir), nil
+	return redis_token_repo.NewRedisAdapter(rdb), nil
 }
 
 //
@@ -1,11 +1,36 @@
 package aruba
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	std_transport "github.com/Arubacloud/sdk-go/internal/transport"
 )
 
 // TestNewClient_BuildsAllSubsystems verifies that NewClient with valid Options
@@ -193,3 +218,1060 @@ func TestNewClient_WithStaticToken(t *testing.T) {
 		t.Fatal("NewClient with static token returned nil")
 	}
 }
+
+// --------------------------------------------------------------------------
+// Retry policy
+// --------------------------------------------------------------------------
+
+func TestClient_RetriesTransientFailures(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"total":0,"values":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithExponentialBackoffRetries(3, time.Millisecond, 10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := cli.FromProject().List(context.Background()); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestOptions_RetryPolicy(t *testing.T) {
+	base := func() *Options {
+		return NewOptions().WithBaseURL("http://localhost:8080").WithToken("test-token")
+	}
+
+	t.Run("custom policy replaces the built-in one", func(t *testing.T) {
+		o := base().WithStandardRetryPolicy().WithCustomRetryPolicy(stubRetryPolicy{})
+		if o.retryPolicy != nil {
+			t.Error("built-in retry policy should be removed")
+		}
+		if err := o.validate(); err != nil {
+			t.Errorf("validate: %v", err)
+		}
+	})
+
+	t.Run("non-idempotent retries enable the standard policy", func(t *testing.T) {
+		o := base().WithNonIdempotentRetries()
+		if o.retryPolicy == nil || o.retryPolicy.maxAttempts != stdRetryMaxAttempts || !o.retryPolicy.retryNonIdempotent {
+			t.Errorf("unexpected retry policy: %+v", o.retryPolicy)
+		}
+	})
+
+	t.Run("invalid backoff settings are rejected", func(t *testing.T) {
+		o := base().WithExponentialBackoffRetries(0, time.Second, time.Millisecond)
+		if err := o.validate(); err == nil {
+			t.Error("expected validation error")
+		}
+	})
+
+	t.Run("DeepCopy copies the retry settings", func(t *testing.T) {
+		o := base().WithStandardRetryPolicy()
+		cp := o.DeepCopy()
+		cp.retryPolicy.maxAttempts = 10
+		if o.retryPolicy.maxAttempts != stdRetryMaxAttempts {
+			t.Error("DeepCopy should not share retry settings")
+		}
+	})
+
+	t.Run("WithNoRetries clears every policy", func(t *testing.T) {
+		o := base().WithStandardRetryPolicy().WithNoRetries()
+		policy, err := buildRetryPolicy(o)
+		if err != nil || policy != nil {
+			t.Errorf("buildRetryPolicy = %v, %v; want nil, nil", policy, err)
+		}
+	})
+}
+
+type stubRetryPolicy struct{}
+
+func (stubRetryPolicy) ShouldRetry(context.Context, int, *http.Request, *http.Response, error) (time.Duration, bool) {
+	return 0, false
+}
+
+// --------------------------------------------------------------------------
+// Rate limiter
+// --------------------------------------------------------------------------
+
+func TestOptions_RateLimit(t *testing.T) {
+	base := func() *Options {
+		return NewOptions().WithBaseURL("http://localhost:8080").WithToken("test-token")
+	}
+
+	t.Run("built-in settings accumulate", func(t *testing.T) {
+		o := base().WithRateLimit(10, 5, 2, 1).WithMaxInFlightRequests(4)
+		want := rateLimitOptions{readsPerSecond: 10, readBurst: 5, writesPerSecond: 2, writeBurst: 1, maxInFlight: 4}
+		if o.rateLimit == nil || *o.rateLimit != want {
+			t.Errorf("rateLimit = %+v, want %+v", o.rateLimit, want)
+		}
+		if limiter, err := buildRateLimiter(o); err != nil || limiter == nil {
+			t.Errorf("buildRateLimiter = %v, %v; want a limiter", limiter, err)
+		}
+	})
+
+	t.Run("custom limiter replaces the built-in one and survives DeepCopy", func(t *testing.T) {
+		shared := NewRateLimiter(1, 1, 1, 1, 1)
+		o := base().WithRateLimit(10, 5, 2, 1).WithCustomRateLimiter(shared)
+		if o.rateLimit != nil {
+			t.Error("built-in rate limit should be removed")
+		}
+		limiter, err := buildRateLimiter(o.DeepCopy())
+		if err != nil || limiter != shared {
+			t.Errorf("buildRateLimiter = %v, %v; want the shared limiter", limiter, err)
+		}
+	})
+
+	t.Run("negative settings are rejected", func(t *testing.T) {
+		if err := base().WithRateLimit(-1, 0, 0, 0).validate(); err == nil {
+			t.Error("expected validation error")
+		}
+	})
+
+	t.Run("WithNoRateLimit clears every limiter", func(t *testing.T) {
+		o := base().WithMaxInFlightRequests(1).WithNoRateLimit()
+		if limiter, err := buildRateLimiter(o); err != nil || limiter != nil {
+			t.Errorf("buildRateLimiter = %v, %v; want nil, nil", limiter, err)
+		}
+	})
+}
+
+// --------------------------------------------------------------------------
+// Round-trip middleware
+// --------------------------------------------------------------------------
+
+func TestClient_RoundTripMiddleware(t *testing.T) {
+	var serverAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"total":0,"values":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	var seenAuth string
+	var seenStatus int
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithCustomMiddleware(MiddlewareFunc(func(ctx context.Context, r *http.Request, next RoundTripFunc) (*http.Response, error) {
+			seenAuth = r.Header.Get("Authorization")
+			resp, err := next(ctx, r)
+			if resp != nil {
+				seenStatus = resp.StatusCode
+			}
+			return resp, err
+		})))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := cli.FromProject().List(context.Background()); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	if seenStatus != http.StatusOK {
+		t.Errorf("middleware saw status %d, want %d", seenStatus, http.StatusOK)
+	}
+	if seenAuth != "" {
+		t.Errorf("middleware should run before the token manager, saw Authorization %q", seenAuth)
+	}
+	if serverAuth != "Bearer test-token" {
+		t.Errorf("server saw Authorization %q, want %q", serverAuth, "Bearer test-token")
+	}
+}
+
+func TestClient_ClientTraceHooks(t *testing.T) {
+	var srvURL string
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if calls == 1 {
+			_, _ = fmt.Fprintf(w, `{"total":2,"next":%q,"values":[{"metadata":{"id":"proj-1"},"properties":{}}]}`, srvURL+"/projects?page=2")
+			return
+		}
+		_, _ = w.Write([]byte(`{"total":2,"values":[{"metadata":{"id":"proj-2"},"properties":{}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	var starts, dones int
+	var sources []TokenSource
+	var pages []PageInfo
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithClientTrace(&ClientTrace{
+			RequestStart:  func(context.Context, RequestStartInfo) { starts++ },
+			RequestDone:   func(context.Context, RequestDoneInfo) { dones++ },
+			TokenObtained: func(_ context.Context, info TokenInfo) { sources = append(sources, info.Source) },
+			PageFetched:   func(_ context.Context, info PageInfo) { pages = append(pages, info) },
+		}))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	list, err := cli.FromProject().List(context.Background())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if _, err := list.Next(context.Background()); err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	if starts != 2 || dones != 2 {
+		t.Errorf("RequestStart/RequestDone calls = %d/%d, want 2/2", starts, dones)
+	}
+	if !reflect.DeepEqual(sources, []TokenSource{TokenFromRepository, TokenFromRepository}) {
+		t.Errorf("token sources = %v, want the static token from the repository twice", sources)
+	}
+	if len(pages) != 1 || pages[0].URL != srv.URL+"/projects?page=2" || pages[0].StatusCode != http.StatusOK {
+		t.Errorf("PageFetched calls = %+v, want one fetch of page 2", pages)
+	}
+}
+
+func TestOptions_RejectsUnsupportedMiddleware(t *testing.T) {
+	o := NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithToken("test-token").
+		WithCustomMiddleware("not a middleware")
+
+	if err := o.validate(); err == nil {
+		t.Error("expected validation error for unsupported middleware type")
+	}
+}
+
+func TestClient_SlogLogger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"title":"Not Found","status":404}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	var buf bytes.Buffer
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithSlogLogger(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, _ = cli.FromCompute().CloudServers().Get(context.Background(), URI("/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"))
+
+	records := make(map[string]map[string]any)
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var record map[string]any
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Fatalf("record %q is not JSON: %v", line, err)
+		}
+		records[record["msg"].(string)] = record
+	}
+
+	attempt, ok := records["Attempt completed"]
+	if !ok {
+		t.Fatalf("records = %v, want an attempt record", records)
+	}
+	if attempt[LogKeyMethod] != http.MethodGet || attempt[LogKeyPath] != "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1" ||
+		attempt[LogKeyStatus] != float64(http.StatusNotFound) || attempt[LogKeyAttempt] != float64(1) {
+		t.Errorf("attempt record = %v, want method, path, status and attempt", attempt)
+	}
+
+	call, ok := records["API call completed"]
+	if !ok {
+		t.Fatalf("records = %v, want an API call record", records)
+	}
+	if call[LogKeyProject] != "p-1" || call[LogKeyResourceID] != "cs-1" || call[LogKeyStatus] != float64(http.StatusNotFound) {
+		t.Errorf("API call record = %v, want project, resource ID and status", call)
+	}
+	if _, ok := call[LogKeyDuration]; !ok {
+		t.Errorf("API call record = %v, want a duration", call)
+	}
+}
+
+// linesLogger is a printf-only logger recording every line.
+type linesLogger struct {
+	lines []string
+}
+
+func (l *linesLogger) Debugf(format string, args ...interface{}) { l.add(format, args) }
+func (l *linesLogger) Infof(format string, args ...interface{})  { l.add(format, args) }
+func (l *linesLogger) Warnf(format string, args ...interface{})  { l.add(format, args) }
+func (l *linesLogger) Errorf(format string, args ...interface{}) { l.add(format, args) }
+
+func (l *linesLogger) add(format string, args []interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestClient_RedactsLogs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Session", "server-session")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"echo":"echoed-secret"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	log := &linesLogger{}
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithCustomLogger(log).
+		WithRedactedJSONPaths("echo").
+		WithRedactedHeaders("X-Session"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	cs := &CloudServer{}
+	cs.fromResponse(cloudServerTestResponse("cs-1", "my-server", "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"))
+	cs.projectID = "p-1"
+	cs.actions = cli.FromCompute().CloudServers().(*cloudServersClientAdapter)
+
+	if err := cs.SetPassword(context.Background(), "hunter2"); err != nil {
+		t.Fatalf("SetPassword: %v", err)
+	}
+
+	logs := strings.Join(log.lines, "\n")
+	for _, secret := range []string{"hunter2", "test-token", "echoed-secret", "server-session"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs contain %q:\n%s", secret, logs)
+		}
+	}
+	if !strings.Contains(logs, `{"password":"[REDACTED]"}`) {
+		t.Errorf("logs should contain the redacted request body:\n%s", logs)
+	}
+}
+
+func TestOptions_RedactionValidation(t *testing.T) {
+	_, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithToken("test-token").
+		WithRedactedJSONPaths("metadata..tags"))
+	if err == nil || !strings.Contains(err.Error(), "redaction configuration error") {
+		t.Errorf("NewClient error = %v, want a redaction configuration error", err)
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"title":"Service Unavailable","status":503}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithCircuitBreaker(1, time.Minute, 1))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ref := URI("/projects/p-1/providers/Aruba.Database/dbaas/db-1")
+	if _, err := cli.FromDatabase().DBaaS().Get(context.Background(), ref); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("first Get error = %v, want the server error", err)
+	}
+
+	_, err = cli.FromDatabase().DBaaS().Get(context.Background(), ref)
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.Key != "providers/Aruba.Database" {
+		t.Fatalf("second Get error = %v, want an open circuit error for the database provider", err)
+	}
+	if hits != 1 {
+		t.Errorf("server hits = %d, want 1", hits)
+	}
+}
+
+func TestOptions_CircuitBreakerValidation(t *testing.T) {
+	_, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithToken("test-token").
+		WithCircuitBreaker(0, 0, 1))
+	if err == nil || !strings.Contains(err.Error(), "circuit breaker configuration error") {
+		t.Errorf("NewClient error = %v, want a circuit breaker configuration error", err)
+	}
+}
+
+func TestClient_ResponseCache(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	etag := `"v1"`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			etag = `"v2"`
+			w.WriteHeader(http.StatusAccepted)
+			return
+		case http.MethodGet:
+			w.Header().Set("ETag", etag)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"},"status":{"state":"Active"}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithResponseCache(10))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	get := func() *CloudServer {
+		t.Helper()
+		cs, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri))
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		return cs
+	}
+
+	if cs := get(); cs.FromCache() || cs.Name() != "web" {
+		t.Errorf("first Get = %q (from cache %v), want a fresh response", cs.Name(), cs.FromCache())
+	}
+	if cs := get(); !cs.FromCache() || cs.Name() != "web" || cs.StatusCode() != http.StatusOK {
+		t.Errorf("second Get = %q, status %d (from cache %v), want the cached response", cs.Name(), cs.StatusCode(), cs.FromCache())
+	}
+
+	if err := cli.FromCompute().CloudServers().Delete(context.Background(), URI(uri)); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if cs := get(); cs.FromCache() {
+		t.Error("Get after Delete is from cache, want a fresh response")
+	}
+}
+
+func TestOptions_ResponseCacheValidation(t *testing.T) {
+	_, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithToken("test-token").
+		WithResponseCache(0))
+	if err == nil || !strings.Contains(err.Error(), "response cache configuration error") {
+		t.Errorf("NewClient error = %v, want a response cache configuration error", err)
+	}
+}
+
+func TestClient_TransportSecurity(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/token":
+			_, _ = w.Write([]byte(`{"access_token":"issued-token","token_type":"Bearer","expires_in":3600}`))
+		case r.Header.Get("Authorization") != "Bearer issued-token":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"}}`))
+		}
+	}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	newOptions := func() *Options {
+		return NewOptions().
+			WithBaseURL(srv.URL).
+			WithTokenIssuerURL(srv.URL+"/token").
+			WithClientCredentials("test-id", "test-secret").
+			WithRootCAs(certPEM)
+	}
+
+	// The token issuer and the API are both verified with the custom CA.
+	cli, err := NewClient(newOptions().WithPinnedPublicKeys(std_transport.Pin(srv.Certificate())))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri)); err != nil {
+		t.Errorf("Get with the custom CA and pin: %v", err)
+	}
+
+	cli, err = NewClient(newOptions().WithPinnedPublicKeys("sha256/LHDhK3oGRvkiefQnx7OOczTY5Tic/xZ6HcMOc/gmtoM="))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri)); !errors.Is(err, std_transport.ErrPinMismatch) {
+		t.Errorf("Get with a wrong pin error = %v, want ErrPinMismatch", err)
+	}
+}
+
+// recordingTransport records the paths of the requests it sends.
+type recordingTransport struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (rt *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	rt.paths = append(rt.paths, r.URL.Path)
+	rt.mu.Unlock()
+
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClient_CustomHTTPClientReachesAuthServices(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/auth/approle/login":
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"vault-token","lease_duration":3600}}`))
+		case "/v1/kv/data/path":
+			_, _ = w.Write([]byte(`{"data":{"data":{"client-id":"vault-id","client-secret":"vault-secret"},"metadata":{"version":1}}}`))
+		case "/token":
+			_, _ = w.Write([]byte(`{"access_token":"issued-token","token_type":"Bearer","expires_in":3600}`))
+		default:
+			_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := &recordingTransport{}
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithTokenIssuerURL(srv.URL+"/token").
+		WithVaultCredentialsRepository(srv.URL, "kv", "path", "", "approle", "role", "secret").
+		WithCustomHTTPClient(&http.Client{Transport: transport}).
+		WithNoLogs())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri)); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	// Vault, the token issuer and the API are all reached through the
+	// custom client.
+	want := []string{"/v1/auth/approle/login", "/v1/kv/data/path", "/token", uri}
+	if !reflect.DeepEqual(transport.paths, want) {
+		t.Errorf("paths sent through the custom client = %q, want %q", transport.paths, want)
+	}
+}
+
+func TestClient_RejectedTokenRenewed(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	// The first issued token is revoked as soon as it is issued.
+	var issued, calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			n := issued.Add(1)
+			_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, n)
+			return
+		}
+
+		calls.Add(1)
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"title":"Unauthorized","status":401}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithTokenIssuerURL(srv.URL+"/token").
+		WithClientCredentials("test-id", "test-secret").
+		WithNoLogs())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for range 2 {
+		resp, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri))
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Fatalf("Get status = %d, want 200", resp.StatusCode())
+		}
+	}
+
+	// The first call is replayed once with a fresh token, the second one
+	// reuses it.
+	if issued.Load() != 2 || calls.Load() != 3 {
+		t.Errorf("issued tokens = %d, API calls = %d, want 2 and 3", issued.Load(), calls.Load())
+	}
+}
+
+func TestClient_BackgroundTokenRefresh(t *testing.T) {
+	var issued atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		n := issued.Add(1)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":1}`, n)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithTokenIssuerURL(srv.URL+"/token").
+		WithClientCredentials("test-id", "test-secret").
+		WithBackgroundTokenRefresh(0.5, 10*time.Millisecond, 100*time.Millisecond).
+		WithNoLogs())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	// Tokens are renewed without any request being sent.
+	deadline := time.Now().Add(3 * time.Second)
+	for issued.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if issued.Load() < 2 {
+		t.Fatalf("issued tokens = %d, want at least 2", issued.Load())
+	}
+
+	if err := cli.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	closed := issued.Load()
+	time.Sleep(700 * time.Millisecond)
+	if issued.Load() != closed {
+		t.Errorf("issued tokens after Close = %d, want %d", issued.Load(), closed)
+	}
+}
+
+func TestOptions_BackgroundTokenRefreshValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *Options
+	}{
+		{"fraction", NewOptions().WithBackgroundTokenRefresh(1, time.Second, time.Minute)},
+		{"minimum backoff", NewOptions().WithBackgroundTokenRefresh(0.2, 0, time.Minute)},
+		{"maximum backoff", NewOptions().WithBackgroundTokenRefresh(0.2, time.Minute, time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(tt.options.
+				WithBaseURL("http://localhost:8080").
+				WithTokenIssuerURL("http://localhost:8080/token").
+				WithClientCredentials("test-id", "test-secret"))
+			if err == nil || !strings.Contains(err.Error(), "background token refresh configuration error") {
+				t.Errorf("NewClient error = %v, want a background token refresh configuration error", err)
+			}
+		})
+	}
+
+	if _, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithTokenIssuerURL("http://localhost:8080/token").
+		WithClientCredentials("test-id", "test-secret").
+		WithStandardBackgroundTokenRefresh().
+		WithNoBackgroundTokenRefresh()); err != nil {
+		t.Errorf("NewClient with the background refresh disabled: %v", err)
+	}
+}
+
+func TestClient_DeviceAuthorizationGrant(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	var grants []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/openid-connect/auth/device":
+			_, _ = fmt.Fprintf(w, `{"device_code":"device-code","user_code":"ABCD-EFGH","verification_uri":"%s/activate","expires_in":60,"interval":1}`, "http://"+r.Host)
+		case "/openid-connect/token":
+			_ = r.ParseForm()
+			grants = append(grants, r.PostForm.Get("grant_type"))
+			if r.PostForm.Get("client_id") != "cli" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","refresh_token":"refresh-%d","token_type":"Bearer","expires_in":3600}`, len(grants), len(grants))
+		default:
+			if r.Header.Get("Authorization") != "Bearer token-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var codes []string
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithTokenIssuerURL(srv.URL+"/openid-connect/token").
+		WithClientCredentials("test-id", "test-secret").
+		WithDeviceAuthorizationGrant("cli", func(_ context.Context, authorization DeviceAuthorization) error {
+			codes = append(codes, authorization.UserCode)
+			return nil
+		}).
+		WithNoLogs())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	resp, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("Get status = %d, want 200", resp.StatusCode())
+	}
+
+	// The device endpoint is derived from the token issuer URL, and the
+	// client credentials were replaced by the public client.
+	if len(codes) != 1 || codes[0] != "ABCD-EFGH" {
+		t.Errorf("prompted codes = %q, want the user code once", codes)
+	}
+	if len(grants) != 1 || grants[0] != "urn:ietf:params:oauth:grant-type:device_code" {
+		t.Errorf("grants = %q, want the device code grant", grants)
+	}
+}
+
+func TestOptions_InteractiveGrantValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *Options
+		want    string
+	}{
+		{"client ID", NewOptions().WithDeviceAuthorizationGrant(" ", nil), "client ID is required"},
+		{"listen address", NewOptions().WithAuthorizationCodeGrant("cli", "127.0.0.1", nil), "listen address is malformed"},
+		{"loopback", NewOptions().WithAuthorizationCodeGrant("cli", "0.0.0.0:8250", nil), "must be a loopback address"},
+		{"endpoints", NewOptions().WithAuthorizationCodeGrant("cli", "localhost:0", nil).WithAuthorizationEndpoints("ftp://idp/auth", ""), "authorization URL has invalid scheme"},
+		{"last credentials win", NewOptions().WithDeviceAuthorizationGrant("cli", nil).WithClientCredentials("test-id", "test-secret").WithDeviceAuthorizationGrant("cli", nil), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(tt.options.
+				WithBaseURL("http://localhost:8080").
+				WithTokenIssuerURL("http://localhost:8080/token"))
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("NewClient error = %v, want the last credentials to win", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "interactive grant configuration error") || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("NewClient error = %v, want an interactive grant configuration error about %q", err, tt.want)
+			}
+		})
+	}
+
+	// An issuer URL without a token path gives no endpoint to derive.
+	_, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithTokenIssuerURL("http://localhost:8080/oauth").
+		WithAuthorizationCodeGrant("cli", "[::1]:0", nil))
+	if err == nil || !strings.Contains(err.Error(), "no authorization endpoint defined") {
+		t.Errorf("NewClient error = %v, want a missing authorization endpoint", err)
+	}
+}
+
+func TestClient_ClientAssertionKey(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "client.pem")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var assertions []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			_ = r.ParseForm()
+			if r.PostForm.Get("client_secret") != "" || r.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assertions = append(assertions, r.PostForm.Get("client_assertion"))
+			_, _ = w.Write([]byte(`{"access_token":"token-1","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithTokenIssuerURL(srv.URL+"/token").
+		WithClientAssertionKey("test-id", keyFile, "key-1").
+		WithNoLogs())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri)); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if len(assertions) != 1 {
+		t.Fatalf("client assertions = %d, want 1", len(assertions))
+	}
+	segments := strings.Split(assertions[0], ".")
+	if len(segments) != 3 {
+		t.Fatalf("client assertion = %q, want a JWS", assertions[0])
+	}
+	signature, _ := base64.RawURLEncoding.DecodeString(segments[2])
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	if len(signature) != 64 || !ecdsa.Verify(&key.PublicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])) {
+		t.Errorf("client assertion signature not verified by the key")
+	}
+}
+
+func TestOptions_ClientAssertionValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *Options
+		want    string
+	}{
+		{"client ID", NewOptions().WithClientAssertionKey("", "client.pem", ""), "client assertion configuration error: client ID is required"},
+		{"key", NewOptions().WithClientAssertionSigner("test-id", nil, ""), "client assertion configuration error: private key file or signer is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(tt.options.
+				WithBaseURL("http://localhost:8080").
+				WithTokenIssuerURL("http://localhost:8080/token"))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("NewClient error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	// The last credentials set win.
+	if _, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithTokenIssuerURL("http://localhost:8080/token").
+		WithClientAssertionKey("test-id", "client.pem", "").
+		WithClientCredentials("test-id", "test-secret")); err != nil {
+		t.Errorf("NewClient with client credentials set last: %v", err)
+	}
+}
+
+func TestNewOptionsFromProfile(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+	var clientIDs, scopes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			clientID, _, _ := r.BasicAuth()
+			_ = r.ParseForm()
+			clientIDs = append(clientIDs, clientID)
+			scopes = append(scopes, r.PostForm.Get("scope"))
+			_, _ = w.Write([]byte(`{"access_token":"token-1","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	configFile := filepath.Join(t.TempDir(), "config")
+	config := "[default]\nbase_url = http://unused.example.com\n\n[staging]\n" +
+		"base_url = " + srv.URL + "\ntoken_issuer_url = " + srv.URL + "/token\nscopes = read, write\n" +
+		"client_id = profile-id\nclient_secret = profile-secret\n"
+	if err := os.WriteFile(configFile, []byte(config), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	t.Setenv("ARUBA_CONFIG_FILE", configFile)
+	t.Setenv("ARUBA_PROFILE", "staging")
+
+	// The tokens are persisted under the client ID the chain resolves.
+	tokenDir := t.TempDir()
+	get := func(t *testing.T, clientID string) {
+		t.Helper()
+
+		options, err := NewOptionsFromProfile("")
+		if err != nil {
+			t.Fatalf("NewOptionsFromProfile: %v", err)
+		}
+		cli, err := NewClient(options.WithNoLogs().WithFileTokenRepositoryFromBaseDir(tokenDir))
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+		if _, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri)); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+
+		name := chainTokenKey(clientID, srv.URL+"/token") + ".token.json"
+		if _, err := os.Stat(filepath.Join(tokenDir, name)); err != nil {
+			t.Errorf("token of %s not persisted: %v", clientID, err)
+		}
+	}
+
+	// The credentials of the profile are used when the environment has none.
+	t.Setenv("ARUBA_CLIENT_ID", "")
+	t.Setenv("ARUBA_CLIENT_SECRET", "")
+	get(t, "profile-id")
+
+	// The environment takes precedence, with a token of its own.
+	t.Setenv("ARUBA_CLIENT_ID", "env-id")
+	t.Setenv("ARUBA_CLIENT_SECRET", "env-secret")
+	get(t, "env-id")
+
+	if want := []string{"profile-id", "env-id"}; !reflect.DeepEqual(clientIDs, want) {
+		t.Errorf("client IDs = %q, want %q", clientIDs, want)
+	}
+	if want := []string{"read write", "read write"}; !reflect.DeepEqual(scopes, want) {
+		t.Errorf("scopes = %q, want %q", scopes, want)
+	}
+
+	if _, err := NewOptionsFromProfile("production"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("NewOptionsFromProfile(production) error = %v, want ErrProfileNotFound", err)
+	}
+
+	t.Setenv("ARUBA_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	if _, err := NewOptionsFromProfile(""); !errors.Is(err, ErrProfilesFileNotFound) {
+		t.Errorf("NewOptionsFromProfile() error = %v, want ErrProfilesFileNotFound", err)
+	}
+}
+
+func TestOptions_CredentialsChainValidation(t *testing.T) {
+	base := func() *Options {
+		return NewOptions().
+			WithBaseURL("http://localhost:8080").
+			WithTokenIssuerURL("http://localhost:8080/token")
+	}
+
+	// Vault is the last link of the chain rather than a conflicting source.
+	if _, err := NewClient(base().
+		WithCredentialsChain("default").
+		WithVaultCredentialsRepository("http://localhost:8200", "kv", "path", "", "approle", "role", "secret")); err != nil {
+		t.Errorf("NewClient with the chain and Vault: %v", err)
+	}
+
+	// Its configuration is still validated.
+	_, err := NewClient(base().
+		WithVaultCredentialsRepository("", "kv", "path", "", "approle", "role", "secret").
+		WithCredentialsChain("default"))
+	if err == nil || !strings.Contains(err.Error(), "vault configuration error") {
+		t.Errorf("NewClient error = %v, want a vault configuration error", err)
+	}
+
+	// The other sources replace the chain.
+	if _, err := NewClient(base().
+		WithCredentialsChain("default").
+		WithClientCredentials("test-id", "test-secret")); err != nil {
+		t.Errorf("NewClient with client credentials set last: %v", err)
+	}
+}
+
+func TestOptions_TransportValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *Options
+		want    string
+	}{
+		{"proxy scheme", NewOptions().WithProxy("ftp://proxy:21"), "invalid scheme"},
+		{"pin", NewOptions().WithPinnedPublicKeys("sha256/short"), "invalid public key pin"},
+		{"TLS version", NewOptions().WithMinTLSVersion(tls.VersionTLS11), "unsupported minimum TLS version"},
+		{"timeouts", NewOptions().WithTransportTimeouts(-time.Second, 0, 0), "timeouts cannot be negative"},
+		{"custom HTTP client", NewOptions().WithProxy("http://proxy:3128").WithCustomHTTPClient(http.DefaultClient), "configuration conflict"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(tt.options.WithBaseURL("http://localhost:8080").WithToken("test-token"))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("NewClient error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	_, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithToken("test-token").
+		WithRootCAsFromFile(filepath.Join(t.TempDir(), "missing.pem")))
+	if err == nil || !strings.Contains(err.Error(), "failed to read the root CAs") {
+		t.Errorf("NewClient error = %v, want a root CAs read error", err)
+	}
+}
+
+func TestClient_Compression(t *testing.T) {
+	const uri = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+	payload := `{"metadata":{"id":"cs-1","name":"web","uri":"` + uri + `"},"status":{"state":"Active"}}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, _ = zw.Write([]byte(payload))
+		_ = zw.Close()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(buf.Bytes())
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithCustomHTTPClient(&http.Client{Transport: &http.Transport{DisableCompression: true}}).
+		WithStandardCompression())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	cs, err := cli.FromCompute().CloudServers().Get(context.Background(), URI(uri))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, raw := cs.RawHTTP(); cs.Name() != "web" || string(raw) != payload {
+		t.Errorf("Get = %q, raw body %q, want the decompressed payload", cs.Name(), raw)
+	}
+}
+
+func TestOptions_CompressionValidation(t *testing.T) {
+	_, err := NewClient(NewOptions().
+		WithBaseURL("http://localhost:8080").
+		WithToken("test-token").
+		WithCompression(-1))
+	if err == nil || !strings.Contains(err.Error(), "compression configuration error") {
+		t.Errorf("NewClient error = %v, want a compression configuration error", err)
+	}
+}
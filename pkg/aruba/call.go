@@ -0,0 +1,136 @@
+package aruba
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Arubacloud/sdk-go/internal/restclient"
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+// ErrUnsupportedClient is returned by Call and Do for Client implementations
+// not built by NewClient, e.g. test doubles.
+var ErrUnsupportedClient = errors.New("client does not support raw calls")
+
+// restClientProvider is implemented by the clients built by NewClient.
+type restClientProvider interface {
+	restClient() *restclient.Client
+}
+
+// Call sends a request to an API endpoint not wrapped by the SDK yet, through
+// the same pipeline as the resource clients: base URL, authentication,
+// interceptors, logging, retries, rate limiting and circuit breaker.
+//
+// path is relative to the base URL and may carry a query string. body is sent
+// as is when it is a []byte, a json.RawMessage or an io.Reader, marshaled to
+// JSON otherwise, and omitted when nil. The call options add their query
+// parameters (e.g. WithAPIVersion, WithFilter) and headers (e.g. WithIfMatch,
+// WithIdempotencyKey).
+//
+// A 2xx JSON body is decoded into the Data of the response. Any other status
+// is returned as an *HTTPError, along with the response.
+func Call[T any](ctx context.Context, client Client, method, path string, body any, opts ...CallOption) (_ *types.Response[T], err error) {
+	provider, ok := client.(restClientProvider)
+	if !ok || provider.restClient() == nil {
+		return nil, ErrUnsupportedClient
+	}
+	rest := provider.restClient()
+
+	ctx, op := startOperation(ctx, rest, "Client.Call", "Raw", URI(path))
+	defer func() { op.end(err) }()
+
+	reader, err := requestBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
+	rp := co.toRawParameters()
+
+	httpResp, err := rest.DoRequest(ctx, method, path, reader, rp.ToQueryParams(), rp.ToHeaders())
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	resp, err := types.ParseResponseBody[T](httpResp, rest.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return resp, newHTTPError(resp, nil)
+	}
+	return resp, nil
+}
+
+// StreamList sends a GET request to a list endpoint, through the same
+// pipeline as Call, and passes the items of the returned page to yield as
+// they are decoded from the response, so large pages (e.g. audit exports) are
+// never held in memory as a whole. Iteration stops early if yield returns
+// false.
+//
+// path is relative to the base URL, or the absolute Next link of a previous
+// page. The Data of the response holds the pagination metadata of the page;
+// its RawBody is left empty. Any non-2xx status is returned as an *HTTPError,
+// along with the response.
+func StreamList[T any](ctx context.Context, client Client, path string, yield func(T) bool, opts ...CallOption) (_ *types.Response[types.ListResponse], err error) {
+	provider, ok := client.(restClientProvider)
+	if !ok || provider.restClient() == nil {
+		return nil, ErrUnsupportedClient
+	}
+	rest := provider.restClient()
+
+	ctx, op := startOperation(ctx, rest, "Client.StreamList", "Raw", URI(path))
+	defer func() { op.end(err) }()
+
+	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
+	rp := co.toRawParameters()
+
+	doRequest := rest.DoRequest
+	if u, parseErr := url.Parse(path); parseErr == nil && u.IsAbs() {
+		doRequest = rest.DoRequestAbs
+	}
+
+	httpResp, err := doRequest(ctx, http.MethodGet, path, nil, rp.ToQueryParams(), rp.ToHeaders())
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	resp, err := types.ParseListResponseBody(httpResp, rest.Logger(), yield)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return resp, newHTTPError(resp, nil)
+	}
+	return resp, nil
+}
+
+// requestBody returns the reader of a Call body.
+func requestBody(body any) (io.Reader, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case io.Reader:
+		return b, nil
+	case []byte:
+		return bytes.NewReader(b), nil
+	case json.RawMessage:
+		return bytes.NewReader(b), nil
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	return bytes.NewReader(data), nil
+}
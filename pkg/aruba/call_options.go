@@ -1,6 +1,14 @@
 package aruba
 
-import "github.com/Arubacloud/sdk-go/pkg/types"
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/retry"
+	"github.com/Arubacloud/sdk-go/internal/restclient"
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
 
 // CallOption configures an optional per-call parameter. Use these instead of
 // constructing a *types.RequestParameters by hand. Options are applied in order;
@@ -14,6 +22,25 @@ type callOptions struct {
 	offset     *int32
 	limit      *int32
 	apiVersion *string
+
+	// ifMatch is an explicit If-Match precondition; versionCheck requests
+	// the wrapper's last-seen version to be sent instead.
+	ifMatch      *string
+	versionCheck bool
+
+	idempotencyKey *string
+
+	accept    *types.AcceptHeader
+	requestID *string
+	headers   map[string]string
+
+	dryRun bool
+
+	// timeout and retryPolicy are applied by the REST client; retryOverride
+	// tells a nil retryPolicy (no retries) apart from no override.
+	timeout       time.Duration
+	retryPolicy   retry.Policy
+	retryOverride bool
 }
 
 // WithFilter sets the server-side filter expression.
@@ -48,6 +75,82 @@ func WithAPIVersion(v string) CallOption {
 	return func(o *callOptions) { o.apiVersion = &v }
 }
 
+// WithVersionCheck makes an Update conditional on the resource being
+// unchanged since the wrapper was last fetched: its Version() is sent in the
+// If-Match header and the call fails with a *ConflictError when the server
+// holds a newer version. Wrappers without a version are updated
+// unconditionally. Ignored by calls other than Update.
+func WithVersionCheck() CallOption {
+	return func(o *callOptions) { o.versionCheck = true }
+}
+
+// WithIfMatch makes an Update conditional on the server holding the given
+// resource version, failing with a *ConflictError otherwise. It takes
+// precedence over WithVersionCheck. Also sent by Call, StreamList and
+// Client.Do; ignored by the other calls.
+func WithIfMatch(version string) CallOption {
+	return func(o *callOptions) { o.ifMatch = &version }
+}
+
+// WithIdempotencyKey sends key in the Idempotency-Key header of a mutating
+// call, so that the API applies it at most once however many times it is
+// sent. Reuse the same key when re-issuing a call which failed ambiguously
+// (e.g. timed out). Create calls carrying a key also recover from ambiguous
+// failures by themselves: see Options.WithIdempotencyKeys.
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) { o.idempotencyKey = &key }
+}
+
+// WithHeader sends an extra HTTP header with the call. It cannot override the
+// headers managed by the SDK (Authorization, Content-Type, User-Agent) nor
+// those set by the dedicated options (e.g. WithAccept, WithIfMatch).
+func WithHeader(name, value string) CallOption {
+	return func(o *callOptions) {
+		if o.headers == nil {
+			o.headers = map[string]string{}
+		}
+		o.headers[name] = value
+	}
+}
+
+// WithAccept sets the media type accepted in the response, e.g. to download
+// a binary artifact.
+func WithAccept(accept AcceptHeader) CallOption {
+	return func(o *callOptions) { o.accept = &accept }
+}
+
+// WithRequestID sends id in the X-Request-ID header of every request of the
+// call, to correlate it with the logs of the API. The SDK logs it with every
+// attempt under the LogKeyRequestID field.
+func WithRequestID(id string) CallOption {
+	return func(o *callOptions) { o.requestID = &id }
+}
+
+// WithRequestTimeout bounds every request of the call, its retries included,
+// by d. A deadline of the context still applies: the earlier of the two
+// ends the request, which then fails with an error matching
+// context.DeadlineExceeded. Not to be confused with the WithTimeout wait
+// option, bounding a whole polling loop.
+func WithRequestTimeout(d time.Duration) CallOption {
+	return func(o *callOptions) { o.timeout = d }
+}
+
+// WithRetryPolicy retries the requests of the call according to policy (see
+// NewRetryPolicy) instead of the policy of the client. A nil policy disables
+// retries for the call.
+func WithRetryPolicy(policy RetryPolicy) CallOption {
+	return func(o *callOptions) {
+		o.retryPolicy = policy
+		o.retryOverride = true
+	}
+}
+
+// WithNoRetries disables the retries of the call, whatever the policy of the
+// client.
+func WithNoRetries() CallOption {
+	return WithRetryPolicy(nil)
+}
+
 // WithRawParameters seeds the call options from p. Fields in p overwrite any
 // previously set options; fields that are nil in p are not written, preserving
 // earlier options for those fields. Subsequent CallOption values applied after
@@ -75,9 +178,35 @@ func WithRawParameters(p *types.RequestParameters) CallOption {
 		if p.APIVersion != nil {
 			o.apiVersion = p.APIVersion
 		}
+		if p.IfMatch != nil {
+			o.ifMatch = p.IfMatch
+		}
+		if p.IdempotencyKey != nil {
+			o.idempotencyKey = p.IdempotencyKey
+		}
+		if p.Accept != nil {
+			o.accept = p.Accept
+		}
+		if p.RequestID != nil {
+			o.requestID = p.RequestID
+		}
+		if len(p.Headers) > 0 {
+			if o.headers == nil {
+				o.headers = map[string]string{}
+			}
+			maps.Copy(o.headers, p.Headers)
+		}
 	}
 }
 
+// WithDryRun captures the mutating request of the call into the plan returned
+// by Client.DryRunPlan instead of sending it, as if the client was built with
+// Options.WithDryRun. The call fails with ErrDryRun. Calls only sending GET
+// requests are not affected.
+func WithDryRun() CallOption {
+	return func(o *callOptions) { o.dryRun = true }
+}
+
 // applyCallOptions applies opts in order and returns the assembled callOptions.
 func applyCallOptions(opts []CallOption) callOptions {
 	var o callOptions
@@ -87,15 +216,84 @@ func applyCallOptions(opts []CallOption) callOptions {
 	return o
 }
 
+// bind returns ctx carrying the options applied by the REST client itself
+// rather than sent as request parameters.
+func (o *callOptions) bind(ctx context.Context) context.Context {
+	if o.dryRun {
+		ctx = restclient.ContextWithDryRun(ctx)
+	}
+	if o.timeout > 0 {
+		ctx = restclient.ContextWithTimeout(ctx, o.timeout)
+	}
+	if o.retryOverride {
+		ctx = restclient.ContextWithRetryPolicy(ctx, o.retryPolicy)
+	}
+	return ctx
+}
+
 // toRequestParameters converts the resolved callOptions into a *types.RequestParameters.
 // Never returns nil.
 func (o *callOptions) toRequestParameters() *types.RequestParameters {
 	return &types.RequestParameters{
-		Filter:     o.filter,
-		Sort:       o.sort,
-		Projection: o.projection,
-		Offset:     o.offset,
-		Limit:      o.limit,
-		APIVersion: o.apiVersion,
+		Filter:         o.filter,
+		Sort:           o.sort,
+		Projection:     o.projection,
+		Offset:         o.offset,
+		Limit:          o.limit,
+		APIVersion:     o.apiVersion,
+		IdempotencyKey: o.idempotencyKey,
+		Accept:         o.accept,
+		RequestID:      o.requestID,
+		Headers:        maps.Clone(o.headers),
+	}
+}
+
+// toUpdateParameters is toRequestParameters for Update calls: it adds the
+// If-Match precondition requested by WithIfMatch, or by WithVersionCheck
+// using the wrapper's last-seen version. Never returns nil.
+func (o *callOptions) toUpdateParameters(version string) *types.RequestParameters {
+	rp := o.toRequestParameters()
+
+	if o.ifMatch != nil && *o.ifMatch != "" {
+		version = *o.ifMatch
+	} else if !o.versionCheck {
+		version = ""
 	}
+
+	if version != "" {
+		tag := entityTag(version)
+		rp.IfMatch = &tag
+	}
+
+	return rp
+}
+
+// toRawParameters is toRequestParameters for Call and StreamList, which
+// know no wrapper version: only the If-Match precondition requested by
+// WithIfMatch is added. Never returns nil.
+func (o *callOptions) toRawParameters() *types.RequestParameters {
+	rp := o.toRequestParameters()
+
+	if o.ifMatch != nil && *o.ifMatch != "" {
+		tag := entityTag(*o.ifMatch)
+		rp.IfMatch = &tag
+	}
+
+	return rp
+}
+
+// toCreateParameters is toRequestParameters for Create calls: unless set by
+// WithIdempotencyKey, the idempotency key is generated by the client when
+// automatic keys are enabled, so that it is known before the first attempt
+// and reused if the creation has to be re-sent. Never returns nil.
+func (o *callOptions) toCreateParameters(rest *restclient.Client) *types.RequestParameters {
+	rp := o.toRequestParameters()
+
+	if rp.IdempotencyKey == nil {
+		if key := rest.NewIdempotencyKey(); key != "" {
+			rp.IdempotencyKey = &key
+		}
+	}
+
+	return rp
 }
@@ -1,7 +1,13 @@
 package aruba
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/Arubacloud/sdk-go/pkg/types"
 	"k8s.io/utils/ptr"
@@ -114,3 +120,148 @@ func TestCallOptions_WithRawParametersNil(t *testing.T) {
 		t.Errorf("expected filter 'keep' after WithRawParameters(nil), got %v", opts.filter)
 	}
 }
+
+func TestCallOptions_HeadersToRequestParameters(t *testing.T) {
+	opts := applyCallOptions([]CallOption{
+		WithAccept("application/yaml"),
+		WithRequestID("req-1"),
+		WithHeader("X-Tenant", "t1"),
+		WithHeader("X-Trace", "on"),
+	})
+	rp := opts.toRequestParameters()
+
+	if rp.Accept == nil || *rp.Accept != "application/yaml" {
+		t.Errorf("Accept = %v", rp.Accept)
+	}
+	if rp.RequestID == nil || *rp.RequestID != "req-1" {
+		t.Errorf("RequestID = %v", rp.RequestID)
+	}
+	if rp.Headers["X-Tenant"] != "t1" || rp.Headers["X-Trace"] != "on" {
+		t.Errorf("Headers = %v", rp.Headers)
+	}
+
+	headers := rp.ToHeaders()
+	if headers["Accept"] != "application/yaml" || headers["X-Request-ID"] != "req-1" || headers["X-Tenant"] != "t1" {
+		t.Errorf("ToHeaders() = %v", headers)
+	}
+}
+
+func TestCallOptions_RawHeadersMerged(t *testing.T) {
+	accept := types.AcceptHeader("text/plain")
+	raw := &types.RequestParameters{
+		Accept:    &accept,
+		RequestID: ptr.To("from-raw"),
+		Headers:   map[string]string{"X-Raw": "1"},
+	}
+	opts := applyCallOptions([]CallOption{
+		WithHeader("X-Explicit", "1"),
+		WithRawParameters(raw),
+	})
+
+	if opts.accept == nil || *opts.accept != accept {
+		t.Errorf("accept = %v", opts.accept)
+	}
+	if opts.requestID == nil || *opts.requestID != "from-raw" {
+		t.Errorf("requestID = %v", opts.requestID)
+	}
+	if opts.headers["X-Raw"] != "1" || opts.headers["X-Explicit"] != "1" {
+		t.Errorf("headers = %v", opts.headers)
+	}
+
+	raw.Headers["X-Raw"] = "changed"
+	if opts.headers["X-Raw"] != "1" {
+		t.Error("headers share the map of the raw parameters")
+	}
+}
+
+func TestCallOptions_ActionMethodHeaders(t *testing.T) {
+	const uri = "/projects/p/providers/Aruba.Container/kaas/k"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == uri {
+			_, _ = w.Write([]byte(`{"metadata":{"id":"k","uri":"` + uri + `","project":{"id":"p"}}}`))
+			return
+		}
+
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("Accept = %q, want application/json", got)
+		}
+		if got := r.Header.Get("X-Tenant"); got != "t1" {
+			t.Errorf("X-Tenant = %q, want t1", got)
+		}
+		if got := r.Header.Get("X-Request-ID"); got != "req-1" {
+			t.Errorf("X-Request-ID = %q, want req-1", got)
+		}
+		_, _ = w.Write([]byte(`{"name":"kubeconfig","content":"apiVersion: v1"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	k, err := cli.FromContainer().KaaS().Get(context.Background(), URI(uri))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	data, err := k.DownloadKubeconfig(context.Background(),
+		WithAccept("application/json"), WithHeader("X-Tenant", "t1"), WithRequestID("req-1"))
+	if err != nil {
+		t.Fatalf("DownloadKubeconfig: %v", err)
+	}
+	if string(data) != "apiVersion: v1" {
+		t.Errorf("kubeconfig = %q", data)
+	}
+}
+
+func TestCallOptions_RequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ref := URI("/projects/p/providers/Aruba.Compute/cloudServers/cs")
+	_, err = cli.FromCompute().CloudServers().Get(context.Background(), ref, WithRequestTimeout(50*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestCallOptions_NoRetries(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token").
+		WithCustomRetryPolicy(NewRetryPolicy(3, time.Millisecond, time.Millisecond)))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ref := URI("/projects/p/providers/Aruba.Compute/cloudServers/cs")
+	_, _ = cli.FromCompute().CloudServers().Get(context.Background(), ref)
+	if got := hits.Load(); got != 3 {
+		t.Errorf("hits with the client policy = %d, want 3", got)
+	}
+
+	hits.Store(0)
+	_, _ = cli.FromCompute().CloudServers().Get(context.Background(), ref, WithNoRetries())
+	if got := hits.Load(); got != 1 {
+		t.Errorf("hits with WithNoRetries = %d, want 1", got)
+	}
+}
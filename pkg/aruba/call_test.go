@@ -0,0 +1,181 @@
+package aruba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type quota struct {
+	Name  string `json:"name"`
+	Limit int    `json:"limit"`
+}
+
+func newRawCallServer(t *testing.T) Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Header.Get("Authorization") != "Bearer test-token":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/p/quotas/cpu":
+			if r.URL.Query().Get("api-version") != "1.1" || r.URL.Query().Get("region") != "ITBG" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"name":"cpu","limit":16}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/p/quotas":
+			if r.URL.Query().Get("offset") == "2" {
+				_, _ = w.Write([]byte(`{"total":3,"values":[{"name":"disk","limit":500}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"total":3,"values":[{"name":"cpu","limit":16},{"name":"ram","limit":64}],"next":"http://` + r.Host + `/projects/p/quotas?offset=2"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/p/quotas/cpu":
+			body, _ := io.ReadAll(r.Body)
+			var q quota
+			if err := json.Unmarshal(body, &q); err != nil || r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write(body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"title":"Not Found","status":404}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return cli
+}
+
+func TestCall(t *testing.T) {
+	cli := newRawCallServer(t)
+
+	resp, err := Call[quota](context.Background(), cli, http.MethodGet, "/projects/p/quotas/cpu?region=ITBG", nil, WithAPIVersion("1.1"))
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Data == nil || resp.Data.Limit != 16 {
+		t.Errorf("Call response = %d %+v", resp.StatusCode, resp.Data)
+	}
+
+	resp, err = Call[quota](context.Background(), cli, http.MethodPut, "/projects/p/quotas/cpu", quota{Name: "cpu", Limit: 32})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if resp.Data == nil || resp.Data.Limit != 32 {
+		t.Errorf("Call data = %+v", resp.Data)
+	}
+}
+
+func TestCall_HTTPError(t *testing.T) {
+	cli := newRawCallServer(t)
+
+	resp, err := Call[quota](context.Background(), cli, http.MethodGet, "/projects/p/quotas/ram", nil)
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Call error = %v, want a 404 *HTTPError", err)
+	}
+	if httpErr.ErrResp == nil || httpErr.ErrResp.Title == nil || *httpErr.ErrResp.Title != "Not Found" {
+		t.Errorf("ErrResp = %+v", httpErr.ErrResp)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound || resp.Data != nil {
+		t.Errorf("response = %+v, want the 404 response without data", resp)
+	}
+}
+
+func TestStreamList(t *testing.T) {
+	cli := newRawCallServer(t)
+
+	var names []string
+	yield := func(q quota) bool {
+		names = append(names, q.Name)
+		return true
+	}
+
+	resp, err := StreamList(context.Background(), cli, "/projects/p/quotas", yield)
+	if err != nil {
+		t.Fatalf("StreamList: %v", err)
+	}
+	if resp.Data == nil || resp.Data.Total != 3 || resp.Data.Next == "" {
+		t.Fatalf("StreamList page = %+v", resp.Data)
+	}
+
+	if _, err := StreamList(context.Background(), cli, resp.Data.Next, yield); err != nil {
+		t.Fatalf("StreamList next page: %v", err)
+	}
+	if strings.Join(names, ",") != "cpu,ram,disk" {
+		t.Errorf("streamed items = %v, want cpu,ram,disk", names)
+	}
+
+	if _, err := StreamList(context.Background(), cli, "/projects/p/missing", yield); !errors.Is(err, ErrNotFound) {
+		t.Errorf("StreamList error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	cli := newRawCallServer(t)
+
+	var q quota
+	if err := cli.Do(context.Background(), http.MethodPut, "/projects/p/quotas/cpu", []byte(`{"name":"cpu","limit":8}`), &q); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if q.Limit != 8 {
+		t.Errorf("Do decoded %+v", q)
+	}
+
+	if err := cli.Do(context.Background(), http.MethodGet, "/projects/p/quotas/ram", nil, &q); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Do error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCall_IfMatch(t *testing.T) {
+	var ifMatch []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch = append(ifMatch, r.Header.Get("If-Match"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total":0,"values":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := Call[json.RawMessage](ctx, cli, http.MethodPut, "/projects/p/quotas/cpu", quota{Name: "cpu"}, WithIfMatch("3")); err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if _, err := StreamList(ctx, cli, "/projects/p/quotas", func(quota) bool { return true }, WithIfMatch(`W/"4"`)); err != nil {
+		t.Fatalf("StreamList: %v", err)
+	}
+	if err := cli.Do(ctx, http.MethodPut, "/projects/p/quotas/cpu", []byte(`{}`), nil, WithIfMatch("5")); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if err := cli.Do(ctx, http.MethodPut, "/projects/p/quotas/cpu", []byte(`{}`), nil); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	want := []string{`"3"`, `W/"4"`, `"5"`, ""}
+	if strings.Join(ifMatch, ",") != strings.Join(want, ",") {
+		t.Errorf("If-Match headers = %q, want %q", ifMatch, want)
+	}
+}
+
+func TestCall_UnsupportedClient(t *testing.T) {
+	if _, err := Call[quota](context.Background(), &clientImpl{}, http.MethodGet, "/", nil); !errors.Is(err, ErrUnsupportedClient) {
+		t.Errorf("Call error = %v, want ErrUnsupportedClient", err)
+	}
+}
@@ -1,5 +1,14 @@
 package aruba
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	std_token_manager "github.com/Arubacloud/sdk-go/internal/impl/auth/tokenmanager/standard"
+	"github.com/Arubacloud/sdk-go/internal/restclient"
+)
+
 type Client interface {
 	FromAudit() AuditClient
 	FromCompute() ComputeClient
@@ -11,6 +20,19 @@ type Client interface {
 	FromSchedule() ScheduleClient
 	FromSecurity() SecurityClient
 	FromStorage() StorageClient
+
+	// Do sends a request to an API endpoint not wrapped by the SDK yet, like
+	// Call, and decodes a 2xx JSON body into out unless out is nil. Non-2xx
+	// responses are returned as an *HTTPError.
+	Do(ctx context.Context, method, path string, body, out any, opts ...CallOption) error
+
+	// DryRunPlan returns the requests captured in dry-run mode.
+	DryRunPlan() *DryRunPlan
+
+	// Close stops the background token refresh, if enabled, and waits for it
+	// to return. Requests sent afterwards still work, renewing the token
+	// inline once expired.
+	Close() error
 }
 
 type clientImpl struct {
@@ -24,6 +46,10 @@ type clientImpl struct {
 	scheduleClient  ScheduleClient
 	securityClient  SecurityClient
 	storageClient   StorageClient
+
+	rest *restclient.Client
+
+	tokenManager *std_token_manager.TokenManager
 }
 
 var _ Client = (*clientImpl)(nil)
@@ -58,3 +84,35 @@ func (c *clientImpl) FromSecurity() SecurityClient {
 func (c *clientImpl) FromStorage() StorageClient {
 	return c.storageClient
 }
+
+func (c *clientImpl) Do(ctx context.Context, method, path string, body, out any, opts ...CallOption) error {
+	resp, err := Call[json.RawMessage](ctx, c, method, path, body, opts...)
+	if err != nil {
+		return err
+	}
+	if out == nil || resp.Data == nil {
+		return nil
+	}
+	if err := json.Unmarshal(*resp.Data, out); err != nil {
+		return fmt.Errorf("%w: %w", ErrParseResponse, err)
+	}
+	return nil
+}
+
+func (c *clientImpl) DryRunPlan() *DryRunPlan {
+	if c.rest == nil {
+		return nil
+	}
+	return c.rest.Plan()
+}
+
+func (c *clientImpl) Close() error {
+	if c.tokenManager != nil {
+		c.tokenManager.Stop()
+	}
+	return nil
+}
+
+func (c *clientImpl) restClient() *restclient.Client {
+	return c.rest
+}
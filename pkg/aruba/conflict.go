@@ -0,0 +1,124 @@
+package aruba
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+// ConflictError is returned by Update calls made conditional with
+// WithVersionCheck or WithIfMatch when the resource changed on the server in
+// the meantime. It wraps the *HTTPError of the rejected call.
+type ConflictError struct {
+	*HTTPError
+
+	// StaleVersion is the version the update was based on.
+	StaleVersion string
+
+	// CurrentVersion is the version held by the server, or "" when the
+	// response does not report it.
+	CurrentVersion string
+}
+
+func (e *ConflictError) Error() string {
+	if e.CurrentVersion != "" {
+		return fmt.Sprintf("version conflict: updating version %s, server holds version %s: %s", e.StaleVersion, e.CurrentVersion, e.HTTPError)
+	}
+
+	return fmt.Sprintf("version conflict: updating version %s: %s", e.StaleVersion, e.HTTPError)
+}
+
+func (e *ConflictError) Unwrap() error { return e.HTTPError }
+
+// newUpdateError returns the error of an Update the server did not accept: a
+// *ConflictError when the update was conditional and failed with 412
+// Precondition Failed or 409 Conflict, an *HTTPError otherwise.
+func newUpdateError[T any](resp *types.Response[T], rp *types.RequestParameters, fields *fieldSetters) error {
+	httpErr := newHTTPError(resp, fields)
+
+	if rp == nil || rp.IfMatch == nil {
+		return httpErr
+	}
+
+	if resp.StatusCode != http.StatusPreconditionFailed && resp.StatusCode != http.StatusConflict {
+		return httpErr
+	}
+
+	return &ConflictError{
+		HTTPError:      httpErr,
+		StaleVersion:   versionFromEntityTag(*rp.IfMatch),
+		CurrentVersion: versionFromEntityTag(resp.Headers.Get("ETag")),
+	}
+}
+
+// entityTag quotes a resource version as a strong entity tag, unless it
+// already is an entity tag.
+func entityTag(version string) string {
+	if strings.HasPrefix(version, `"`) || strings.HasPrefix(version, `W/"`) {
+		return version
+	}
+
+	return `"` + version + `"`
+}
+
+// versionFromEntityTag is the inverse of entityTag.
+func versionFromEntityTag(tag string) string {
+	return strings.Trim(strings.TrimPrefix(tag, "W/"), `"`)
+}
+
+// versionedClient is satisfied by the resource clients supporting Update,
+// e.g. CloudServersClient.
+type versionedClient[W Ref] interface {
+	Get(ctx context.Context, ref Ref, opts ...CallOption) (W, error)
+	Update(ctx context.Context, w W, opts ...CallOption) (W, error)
+}
+
+// RetryOnConflict performs an optimistic read-modify-write cycle: it fetches
+// the resource identified by ref, applies mutate to the wrapper and updates
+// it with WithVersionCheck. When the update fails with a *ConflictError, the
+// cycle starts over from a fresh copy, up to maxAttempts times in total.
+// mutate must therefore be safe to apply more than once; an error it returns
+// aborts the cycle. opts are passed to both Get and Update.
+//
+//	vpc, err := aruba.RetryOnConflict(ctx, client.FromNetwork().VPCs(), ref, 5,
+//		func(vpc *aruba.VPC) error {
+//			vpc.Tagged("reconciled")
+//			return nil
+//		})
+func RetryOnConflict[W Ref](ctx context.Context, client versionedClient[W], ref Ref, maxAttempts int, mutate func(W) error, opts ...CallOption) (W, error) {
+	var (
+		w   W
+		err error
+	)
+
+	updateOpts := slices.Concat(opts, []CallOption{WithVersionCheck()})
+
+	for range max(maxAttempts, 1) {
+		w, err = client.Get(ctx, ref, opts...)
+		if err != nil {
+			return w, err
+		}
+
+		if err := mutate(w); err != nil {
+			return w, err
+		}
+
+		w, err = client.Update(ctx, w, updateOpts...)
+
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			return w, err
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return w, ctxErr
+		}
+	}
+
+	return w, err
+}
@@ -0,0 +1,178 @@
+package aruba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+const conflictTestVPCURI = "/projects/p-1/providers/Aruba.Network/vpcs/vpc-1"
+
+// newVersionedVPCServer serves a VPC whose version is bumped by every
+// accepted update, and by the given number of concurrent updates happening
+// right after each Get. It records the If-Match headers received.
+func newVersionedVPCServer(t *testing.T, concurrentUpdates int) (cli Client, ifMatch *[]string) {
+	t.Helper()
+
+	version := 1
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := `"` + strconv.Itoa(version) + `"`
+		switch r.Method {
+		case http.MethodGet:
+			defer func() {
+				if concurrentUpdates > 0 {
+					concurrentUpdates--
+					version++
+				}
+			}()
+		case http.MethodPut:
+			received = append(received, r.Header.Get("If-Match"))
+			if match := r.Header.Get("If-Match"); match != "" && match != current {
+				w.Header().Set("ETag", current)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"title":"Precondition Failed","status":412}`))
+				return
+			}
+			version++
+		}
+
+		body, _ := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"id":       "vpc-1",
+				"name":     "vpc",
+				"uri":      conflictTestVPCURI,
+				"version":  strconv.Itoa(version),
+				"location": map[string]any{"value": "ITBG-Bergamo"},
+			},
+			"status": map[string]any{"state": "Active"},
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	return cli, &received
+}
+
+func TestUpdate_WithVersionCheck(t *testing.T) {
+	cli, ifMatch := newVersionedVPCServer(t, 1)
+	vpcs := cli.FromNetwork().VPCs()
+
+	vpc, err := vpcs.Get(context.Background(), URI(conflictTestVPCURI))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	_, err = vpcs.Update(context.Background(), vpc.Tagged("a"), WithVersionCheck())
+
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Update error = %v, want a *ConflictError", err)
+	}
+	if conflict.StaleVersion != "1" || conflict.CurrentVersion != "2" {
+		t.Errorf("versions = stale %q, current %q, want stale %q, current %q", conflict.StaleVersion, conflict.CurrentVersion, "1", "2")
+	}
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Update error = %v, want to wrap a 412 *HTTPError", err)
+	}
+
+	// Without the option the update is unconditional.
+	if _, err := vpcs.Update(context.Background(), vpc); err != nil {
+		t.Fatalf("unconditional Update: %v", err)
+	}
+
+	want := []string{`"1"`, ""}
+	if len(*ifMatch) != len(want) || (*ifMatch)[0] != want[0] || (*ifMatch)[1] != want[1] {
+		t.Errorf("If-Match headers = %q, want %q", *ifMatch, want)
+	}
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	cli, ifMatch := newVersionedVPCServer(t, 2)
+
+	mutations := 0
+	vpc, err := RetryOnConflict(context.Background(), cli.FromNetwork().VPCs(), URI(conflictTestVPCURI), 5,
+		func(vpc *VPC) error {
+			mutations++
+			vpc.Tagged("reconciled")
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("RetryOnConflict: %v", err)
+	}
+	if vpc.Version() != "4" {
+		t.Errorf("Version() = %q, want %q", vpc.Version(), "4")
+	}
+	if mutations != 3 {
+		t.Errorf("mutations = %d, want 3", mutations)
+	}
+	if len(*ifMatch) != 3 {
+		t.Errorf("If-Match headers = %q, want 3 conditional updates", *ifMatch)
+	}
+}
+
+func TestRetryOnConflict_KeepsCallerOptions(t *testing.T) {
+	cli, _ := newVersionedVPCServer(t, 2)
+
+	// Spare capacity would let an append write into the caller's array.
+	opts := make([]CallOption, 1, 4)
+	opts[0] = WithRequestID("reconcile")
+
+	if _, err := RetryOnConflict(context.Background(), cli.FromNetwork().VPCs(), URI(conflictTestVPCURI), 5,
+		func(*VPC) error { return nil }, opts...); err != nil {
+		t.Fatalf("RetryOnConflict: %v", err)
+	}
+	if spare := opts[1:cap(opts)]; spare[0] != nil {
+		t.Error("RetryOnConflict wrote into the backing array of opts")
+	}
+}
+
+func TestRetryOnConflict_GivesUp(t *testing.T) {
+	cli, _ := newVersionedVPCServer(t, 10)
+
+	_, err := RetryOnConflict(context.Background(), cli.FromNetwork().VPCs(), URI(conflictTestVPCURI), 2,
+		func(*VPC) error { return nil })
+
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("RetryOnConflict error = %v, want the last *ConflictError", err)
+	}
+}
+
+func TestRetryOnConflict_MutateError(t *testing.T) {
+	cli, ifMatch := newVersionedVPCServer(t, 0)
+	boom := errors.New("boom")
+
+	_, err := RetryOnConflict(context.Background(), cli.FromNetwork().VPCs(), URI(conflictTestVPCURI), 5,
+		func(*VPC) error { return boom })
+	if !errors.Is(err, boom) {
+		t.Errorf("RetryOnConflict error = %v, want %v", err, boom)
+	}
+	if len(*ifMatch) != 0 {
+		t.Errorf("If-Match headers = %q, want no update", *ifMatch)
+	}
+}
+
+func TestEntityTag(t *testing.T) {
+	for in, want := range map[string]string{`3`: `"3"`, `"3"`: `"3"`, `W/"3"`: `W/"3"`} {
+		if got := entityTag(in); got != want {
+			t.Errorf("entityTag(%q) = %q, want %q", in, got, want)
+		}
+		if got := versionFromEntityTag(want); got != "3" {
+			t.Errorf("versionFromEntityTag(%q) = %q, want %q", want, got, "3")
+		}
+	}
+}
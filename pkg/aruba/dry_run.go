@@ -0,0 +1,17 @@
+package aruba
+
+import "github.com/Arubacloud/sdk-go/internal/restclient"
+
+// DryRunPlan collects the mutating requests captured in dry-run mode (see
+// Options.WithDryRun and the WithDryRun CallOption), in the order they would
+// have been sent. Requests returns a copy of them and Reset discards them.
+type DryRunPlan = restclient.Plan
+
+// PlannedRequest is a request captured in dry-run mode: its method, full URL,
+// headers and JSON body. Credentials are masked in the headers and sensitive
+// fields (e.g. passwords) in the body.
+type PlannedRequest = restclient.PlannedRequest
+
+// ErrDryRun is returned, wrapped, by the calls whose request was captured into
+// the dry-run plan instead of being sent.
+var ErrDryRun = restclient.ErrDryRun
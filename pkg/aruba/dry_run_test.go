@@ -0,0 +1,122 @@
+package aruba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func newDryRunServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	const uri = "/projects/p/providers/Aruba.Compute/cloudServers/cs"
+
+	var (
+		locker sync.Mutex
+		seen   []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locker.Lock()
+		seen = append(seen, r.Method+" "+r.URL.Path)
+		locker.Unlock()
+
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"metadata":{"id":"cs","name":"web","uri":"` + uri + `"},"status":{"state":"Active"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []string {
+		locker.Lock()
+		defer locker.Unlock()
+		return append([]string(nil), seen...)
+	}
+}
+
+func TestClient_DryRun(t *testing.T) {
+	srv, seen := newDryRunServer(t)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token").WithDryRun())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = cli.FromNetwork().VPCs().Create(context.Background(), NewVPC().InProject(URI("/projects/p")).Named("plan-vpc"))
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("Create error = %v, want ErrDryRun", err)
+	}
+
+	cs, err := cli.FromCompute().CloudServers().Get(context.Background(), URI("/projects/p/providers/Aruba.Compute/cloudServers/cs"))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if err := cs.SetPassword(context.Background(), "s3cret!"); !errors.Is(err, ErrDryRun) {
+		t.Fatalf("SetPassword error = %v, want ErrDryRun", err)
+	}
+
+	if got := seen(); len(got) != 1 || got[0] != "GET /projects/p/providers/Aruba.Compute/cloudServers/cs" {
+		t.Errorf("server saw %v, want only the GET", got)
+	}
+
+	plan := cli.DryRunPlan().Requests()
+	if len(plan) != 2 {
+		t.Fatalf("plan = %+v, want 2 requests", plan)
+	}
+
+	create := plan[0]
+	if create.Method != http.MethodPost || !strings.HasPrefix(create.URL, srv.URL+"/projects/p/providers/Aruba.Network/vpcs?") {
+		t.Errorf("planned Create = %s %s", create.Method, create.URL)
+	}
+	if got := create.Header.Get("Authorization"); got == "" || strings.Contains(got, "test-token") {
+		t.Errorf("planned Authorization = %q, want it masked", got)
+	}
+	var body struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(create.Body, &body); err != nil || body.Metadata.Name != "plan-vpc" {
+		t.Errorf("planned Create body = %s (%v)", create.Body, err)
+	}
+
+	if setPassword := plan[1]; strings.Contains(string(setPassword.Body), "s3cret!") {
+		t.Errorf("planned SetPassword body = %s, want the password masked", setPassword.Body)
+	}
+
+	cli.DryRunPlan().Reset()
+	if got := cli.DryRunPlan().Requests(); len(got) != 0 {
+		t.Errorf("plan after Reset = %+v, want it empty", got)
+	}
+}
+
+func TestCallOption_WithDryRun(t *testing.T) {
+	srv, seen := newDryRunServer(t)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ref := URI("/projects/p/providers/Aruba.Compute/cloudServers/cs")
+	if err := cli.FromCompute().CloudServers().Delete(context.Background(), ref, WithDryRun()); !errors.Is(err, ErrDryRun) {
+		t.Fatalf("Delete error = %v, want ErrDryRun", err)
+	}
+	if err := cli.FromCompute().CloudServers().Delete(context.Background(), ref); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if got := seen(); len(got) != 1 || got[0] != "DELETE /projects/p/providers/Aruba.Compute/cloudServers/cs" {
+		t.Errorf("server saw %v, want only the second DELETE", got)
+	}
+	if got := cli.DryRunPlan().Requests(); len(got) != 1 || got[0].Method != http.MethodDelete {
+		t.Errorf("plan = %+v, want the first DELETE", got)
+	}
+}
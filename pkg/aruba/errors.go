@@ -1,17 +1,62 @@
 package aruba
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/Arubacloud/sdk-go/internal/impl/retry/backoff"
+	"github.com/Arubacloud/sdk-go/internal/profile"
+	"github.com/Arubacloud/sdk-go/internal/restclient"
 	"github.com/Arubacloud/sdk-go/pkg/types"
 )
 
-// HTTPError is returned by Delete methods when the server responds with a non-2xx status.
-// Callers can inspect StatusCode, Body, and ErrResp without unwrapping a resource wrapper.
+// Sentinel errors matched, via errors.Is, by every *HTTPError (and
+// *ConflictError) carrying the corresponding status code:
+//
+//	ErrValidation        400 Bad Request, 422 Unprocessable Entity
+//	ErrUnauthorized      401 Unauthorized
+//	ErrForbidden         403 Forbidden
+//	ErrNotFound          404 Not Found
+//	ErrConflict          409 Conflict, 412 Precondition Failed
+//	ErrRateLimited       429 Too Many Requests
+//	ErrServerUnavailable 5xx
+var (
+	ErrValidation        = errors.New("validation failed")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrForbidden         = errors.New("forbidden")
+	ErrNotFound          = errors.New("not found")
+	ErrConflict          = errors.New("conflict")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrServerUnavailable = errors.New("server unavailable")
+)
+
+// Sentinel errors wrapped by the calls whose response could not be decoded.
+var (
+	ErrReadResponse  = types.ErrReadResponse
+	ErrParseResponse = types.ErrParseResponse
+)
+
+// Sentinel errors wrapped by NewOptionsFromProfile when the profile cannot be
+// read.
+var (
+	ErrProfilesFileNotFound = profile.ErrFileNotFound
+	ErrProfileNotFound      = profile.ErrProfileNotFound
+)
+
+// HTTPError is returned when the server responds with a non-2xx status.
+// Callers can inspect StatusCode, Body, and ErrResp without unwrapping a resource wrapper,
+// or branch on the status class with errors.Is and the sentinel errors above.
 type HTTPError struct {
 	StatusCode int
 	Body       []byte
 	ErrResp    *types.ErrorResponse
+
+	// Fields lists the validation errors of the response. They are mapped to
+	// the wrapper setters for the Create and Update calls; the other calls
+	// leave Setter empty. Nil when the response reports none.
+	Fields FieldErrors
 }
 
 func (e *HTTPError) Error() string {
@@ -33,3 +78,75 @@ func (e *HTTPError) Error() string {
 	}
 	return fmt.Sprintf("HTTP %d", e.StatusCode)
 }
+
+// Unwrap returns Fields, when the response reported validation errors, so
+// they can be extracted with errors.As.
+func (e *HTTPError) Unwrap() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e.Fields
+}
+
+// Is reports whether the status code of the error is the one of target, one
+// of the sentinel errors above.
+func (e *HTTPError) Is(target error) bool {
+	sentinel := statusSentinel(e.StatusCode)
+	return sentinel != nil && sentinel == target
+}
+
+// statusSentinel returns the sentinel error of a status code, or nil.
+func statusSentinel(statusCode int) error {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return ErrConflict
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+
+	if statusCode >= 500 && statusCode <= 599 {
+		return ErrServerUnavailable
+	}
+
+	return nil
+}
+
+// IsRetryable reports whether the call failing with err may succeed if sent
+// again later:
+//   - an *HTTPError or a restclient.Error with status 429, 502, 503 or 504;
+//   - a transient network error, e.g. a connection reset or a timeout;
+//   - a call rejected because the circuit of its provider is open.
+//
+// Cancelled contexts, expired deadlines and every other error are not
+// retryable. Note that the client already retries these errors according to
+// its retry policy: IsRetryable is meant for callers deciding whether to
+// re-run a whole operation.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return backoff.IsRetryableStatus(httpErr.StatusCode)
+	}
+
+	var sdkErr *restclient.Error
+	if errors.As(err, &sdkErr) {
+		return backoff.IsRetryableStatus(sdkErr.StatusCode) || backoff.IsTransientError(sdkErr.Err)
+	}
+
+	return backoff.IsTransientError(err)
+}
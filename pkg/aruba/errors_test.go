@@ -1,9 +1,17 @@
 package aruba
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"strings"
+	"syscall"
 	"testing"
 
+	"github.com/Arubacloud/sdk-go/internal/ports/circuitbreaker"
+	"github.com/Arubacloud/sdk-go/internal/restclient"
 	"github.com/Arubacloud/sdk-go/pkg/types"
 )
 
@@ -50,3 +58,70 @@ func TestHTTPError_NilTitle(t *testing.T) {
 		t.Errorf("Error() = %q", e.Error())
 	}
 }
+
+func TestHTTPError_Is(t *testing.T) {
+	sentinels := []error{ErrValidation, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrRateLimited, ErrServerUnavailable}
+
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{400, ErrValidation},
+		{401, ErrUnauthorized},
+		{403, ErrForbidden},
+		{404, ErrNotFound},
+		{409, ErrConflict},
+		{412, ErrConflict},
+		{422, ErrValidation},
+		{429, ErrRateLimited},
+		{500, ErrServerUnavailable},
+		{503, ErrServerUnavailable},
+		{418, nil},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("get: %w", &HTTPError{StatusCode: tt.statusCode})
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+				t.Errorf("errors.Is(HTTP %d, %v) = %v", tt.statusCode, sentinel, got)
+			}
+		}
+	}
+}
+
+func TestConflictError_IsConflict(t *testing.T) {
+	err := &ConflictError{HTTPError: &HTTPError{StatusCode: 412}, StaleVersion: "1"}
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("errors.Is(ConflictError, ErrConflict) = false")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"too many requests", &HTTPError{StatusCode: 429}, true},
+		{"service unavailable", fmt.Errorf("list: %w", &HTTPError{StatusCode: 503}), true},
+		{"internal server error", &HTTPError{StatusCode: 500}, false},
+		{"not found", &HTTPError{StatusCode: 404}, false},
+		{"sdk error with retryable status", restclient.NewError(502, "bad gateway", nil, nil), true},
+		{"sdk error wrapping a connection reset", restclient.NewError(0, "request failed", nil, syscall.ECONNRESET), true},
+		{"sdk error with client status", restclient.NewError(400, "bad request", nil, nil), false},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, true},
+		{"unexpected EOF", fmt.Errorf("request failed: %w", io.ErrUnexpectedEOF), true},
+		{"circuit open", &circuitbreaker.OpenError{Key: "providers/Aruba.Compute"}, true},
+		{"canceled", fmt.Errorf("request failed: %w", context.Canceled), false},
+		{"parse failure", fmt.Errorf("%w: unexpected end of JSON input", ErrParseResponse), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package aruba
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+// FieldError is a validation error reported by the server, attached to the
+// wrapper setter providing the offending field.
+type FieldError struct {
+	// Path is the wire path reported by the server, e.g.
+	// "properties.subnets[0].uri".
+	Path string
+
+	// Setter is the qualified name of the setter providing the field, e.g.
+	// "CloudServer.OnSubnets", or "" when the path matches no known setter.
+	Setter string
+
+	// Index is the position of the offending element among the values given
+	// to a variadic setter (e.g. the subnet passed first to OnSubnets), or -1.
+	Index int
+
+	// Message is the validation message of the server.
+	Message string
+}
+
+func (e FieldError) Error() string {
+	field := e.Path
+	if e.Setter != "" {
+		field = e.Setter
+		if e.Index >= 0 {
+			field += "[" + strconv.Itoa(e.Index) + "]"
+		}
+	}
+	if field == "" {
+		return e.Message
+	}
+	return field + ": " + e.Message
+}
+
+// FieldErrors lists the validation errors of a rejected Create or Update.
+// It is carried by HTTPError.Fields and can be extracted with errors.As.
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// For returns the errors attached to the given qualified setter name, e.g.
+// "CloudServer.OnSubnets".
+func (e FieldErrors) For(setter string) FieldErrors {
+	var out FieldErrors
+	for _, fe := range e {
+		if fe.Setter == setter {
+			out = append(out, fe)
+		}
+	}
+	return out
+}
+
+// fieldSetters maps the wire paths of a request body to the wrapper setters
+// providing them. Paths are dot-separated JSON names without array indexes
+// and match any deeper path. Unqualified setters belong to the wrapper,
+// qualified ones (e.g. "NodePool.OfInstance") to a nested builder.
+type fieldSetters struct {
+	wrapper string
+	paths   map[string]string
+}
+
+func newFieldSetters(wrapper string, paths map[string]string) *fieldSetters {
+	lowered := make(map[string]string, len(paths))
+	for path, setter := range paths {
+		lowered[strings.ToLower(path)] = setter
+	}
+	return &fieldSetters{wrapper: wrapper, paths: lowered}
+}
+
+// regionalFieldSetters returns the field setters of a wrapper whose request
+// carries a regional metadata block, on top of its own paths.
+func regionalFieldSetters(wrapper string, paths map[string]string) *fieldSetters {
+	merged := map[string]string{
+		"metadata.name":           "Named",
+		"metadata.tags":           "Tagged",
+		"metadata.location.value": "InRegion",
+	}
+	maps.Copy(merged, paths)
+	return newFieldSetters(wrapper, merged)
+}
+
+// resolve maps a server validation error to the setter providing its field,
+// using the longest known prefix of its path. Paths are matched
+// case-insensitively.
+func (f *fieldSetters) resolve(ve types.ValidationError) FieldError {
+	fe := FieldError{Path: ve.Field, Index: -1, Message: ve.Message}
+	if f == nil {
+		return fe
+	}
+
+	path := strings.TrimPrefix(strings.TrimSpace(ve.Field), "$.")
+	segments := strings.Split(strings.ToLower(path), ".")
+	indexes := make([]int, len(segments))
+	for i, segment := range segments {
+		segments[i], indexes[i] = splitIndex(segment)
+	}
+
+	for n := len(segments); n > 0; n-- {
+		setter, ok := f.paths[strings.Join(segments[:n], ".")]
+		if !ok {
+			continue
+		}
+		if !strings.Contains(setter, ".") {
+			setter = f.wrapper + "." + setter
+		}
+		fe.Setter = setter
+		for _, index := range indexes[:n] {
+			if index >= 0 {
+				fe.Index = index
+			}
+		}
+		break
+	}
+
+	return fe
+}
+
+// splitIndex splits a path segment such as "subnets[0]" into its name and
+// index, or -1 when it has none.
+func splitIndex(segment string) (string, int) {
+	open := strings.IndexByte(segment, '[')
+	if open < 0 || !strings.HasSuffix(segment, "]") {
+		return segment, -1
+	}
+	index, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+	if err != nil {
+		return segment[:open], -1
+	}
+	return segment[:open], index
+}
+
+// newHTTPError returns the *HTTPError of a non-2xx response, with the
+// validation errors of the body mapped to the wrapper setters.
+func newHTTPError[T any](resp *types.Response[T], fields *fieldSetters) *HTTPError {
+	httpErr := &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+	if resp.Error == nil || len(resp.Error.Errors) == 0 {
+		return httpErr
+	}
+
+	httpErr.Fields = make(FieldErrors, 0, len(resp.Error.Errors))
+	for _, ve := range resp.Error.Errors {
+		httpErr.Fields = append(httpErr.Fields, fields.resolve(ve))
+	}
+
+	return httpErr
+}
@@ -0,0 +1,226 @@
+package aruba
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+func TestFieldSetters_Resolve(t *testing.T) {
+	tests := []struct {
+		fields     *fieldSetters
+		path       string
+		wantSetter string
+		wantIndex  int
+	}{
+		{cloudServerFieldSetters, "properties.subnets[1].uri", "CloudServer.OnSubnets", 1},
+		{cloudServerFieldSetters, "Properties.VPC.Uri", "CloudServer.WithVPC", -1},
+		{cloudServerFieldSetters, "$.metadata.name", "CloudServer.Named", -1},
+		{cloudServerFieldSetters, "metadata.tags[2]", "CloudServer.Tagged", 2},
+		{cloudServerFieldSetters, "properties.unknown", "", -1},
+		{kaasFieldSetters, "properties.nodePools[3].minCount", "NodePool.WithAutoscaling", 3},
+		{kaasFieldSetters, "properties.nodePools[0]", "KaaS.WithNodePools", 0},
+		{vpnTunnelFieldSetters, "properties.vpnClientSettings.ike.dhGroup", "VPNIKE.WithDHGroup", -1},
+		{userFieldSetters, "password", "User.WithPassword", -1},
+	}
+
+	for _, tt := range tests {
+		fe := tt.fields.resolve(types.ValidationError{Field: tt.path, Message: "invalid"})
+		if fe.Setter != tt.wantSetter || fe.Index != tt.wantIndex {
+			t.Errorf("resolve(%q) = %q[%d], want %q[%d]", tt.path, fe.Setter, fe.Index, tt.wantSetter, tt.wantIndex)
+		}
+		if fe.Path != tt.path || fe.Message != "invalid" {
+			t.Errorf("resolve(%q) = %+v, want the path and message preserved", tt.path, fe)
+		}
+	}
+}
+
+func TestSecurityRule_FieldSetters(t *testing.T) {
+	ve := types.ValidationError{Field: "properties.target.value", Message: "invalid"}
+
+	if got := NewSecurityRule().TargetingCIDR("10.0.0.0/24").fieldSetters().resolve(ve).Setter; got != "SecurityRule.TargetingCIDR" {
+		t.Errorf("CIDR target setter = %q", got)
+	}
+	sg := URI("/projects/p/providers/Aruba.Network/vpcs/v/securityGroups/sg")
+	if got := NewSecurityRule().TargetingSecurityGroup(sg).fieldSetters().resolve(ve).Setter; got != "SecurityRule.TargetingSecurityGroup" {
+		t.Errorf("security group target setter = %q", got)
+	}
+}
+
+// TestFieldSetters_Tables checks that every path of the tables exists in the
+// request body and that every setter exists on its builder.
+func TestFieldSetters_Tables(t *testing.T) {
+	builders := map[string]reflect.Type{}
+	for _, b := range []any{
+		&BlockStorage{}, &CloudServer{}, &ContainerRegistry{}, &Database{}, &DBaaS{}, &DBaaSBackup{},
+		&ElasticIP{}, &Grant{}, &Job{}, &JobStep{}, &KaaS{}, &NodePool{}, &Key{}, &KeyPair{}, &Kmip{},
+		&KMS{}, &Project{}, &SecurityGroup{}, &SecurityRule{}, &Snapshot{}, &StorageBackup{},
+		&StorageRestore{}, &Subnet{}, &SubnetDHCPCommon{}, &User{}, &VPC{}, &VPCPeering{},
+		&VPCPeeringRoute{}, &VPNRoute{}, &VPNTunnel{}, &VPNIPConfig{}, &VPNIKE{}, &VPNESP{}, &VPNPSK{},
+	} {
+		builders[reflect.TypeOf(b).Elem().Name()] = reflect.TypeOf(b)
+	}
+
+	tables := []struct {
+		fields  *fieldSetters
+		request any
+	}{
+		{blockStorageFieldSetters, types.BlockStorageRequest{}},
+		{cloudServerFieldSetters, types.CloudServerRequest{}},
+		{containerRegistryFieldSetters, types.ContainerRegistryRequest{}},
+		{databaseFieldSetters, types.DatabaseRequest{}},
+		{dbaasFieldSetters, types.DBaaSRequest{}},
+		{dbaasBackupFieldSetters, types.BackupRequest{}},
+		{elasticIPFieldSetters, types.ElasticIPRequest{}},
+		{grantFieldSetters, types.GrantRequest{}},
+		{jobFieldSetters, types.JobRequest{}},
+		{kaasFieldSetters, types.KaaSRequest{}},
+		{keyFieldSetters, types.KeyRequest{}},
+		{keyPairFieldSetters, types.KeyPairRequest{}},
+		{kmipFieldSetters, types.KmipRequest{}},
+		{kmsFieldSetters, types.KmsRequest{}},
+		{projectFieldSetters, types.ProjectRequest{}},
+		{securityGroupFieldSetters, types.SecurityGroupRequest{}},
+		{securityRuleFieldSetters, types.SecurityRuleRequest{}},
+		{securityRuleGroupFieldSetters, types.SecurityRuleRequest{}},
+		{snapshotFieldSetters, types.SnapshotRequest{}},
+		{storageBackupFieldSetters, types.StorageBackupRequest{}},
+		{storageRestoreFieldSetters, types.StorageRestoreRequest{}},
+		{subnetFieldSetters, types.SubnetRequest{}},
+		{userFieldSetters, types.UserRequest{}},
+		{vpcFieldSetters, types.VPCRequest{}},
+		{vpcPeeringFieldSetters, types.VPCPeeringRequest{}},
+		{vpcPeeringRouteFieldSetters, types.VPCPeeringRouteRequest{}},
+		{vpnRouteFieldSetters, types.VPNRouteRequest{}},
+		{vpnTunnelFieldSetters, types.VPNTunnelRequest{}},
+	}
+
+	for _, table := range tables {
+		for path, setter := range table.fields.paths {
+			if !hasJSONPath(reflect.TypeOf(table.request), strings.Split(path, ".")) {
+				t.Errorf("%s: path %q is not part of %T", table.fields.wrapper, path, table.request)
+			}
+
+			builder, method := table.fields.wrapper, setter
+			if b, m, ok := strings.Cut(setter, "."); ok {
+				builder, method = b, m
+			}
+			typ, ok := builders[builder]
+			if !ok {
+				t.Errorf("%s: unknown builder %q", table.fields.wrapper, builder)
+				continue
+			}
+			if _, ok := typ.MethodByName(method); !ok {
+				t.Errorf("%s: %s has no method %s", table.fields.wrapper, builder, method)
+			}
+		}
+	}
+}
+
+// hasJSONPath reports whether the lowercase JSON path exists in typ.
+func hasJSONPath(typ reflect.Type, path []string) bool {
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if len(path) == 0 {
+		return true
+	}
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous && name == "" {
+			if hasJSONPath(field.Type, path) {
+				return true
+			}
+			continue
+		}
+		if strings.ToLower(name) == path[0] && hasJSONPath(field.Type, path[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestCreate_FieldErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"title":"One or more validation error occurred.","status":400,"errors":[` +
+			`{"field":"properties.subnets[1].uri","message":"subnet not found"},` +
+			`{"field":"metadata.name","message":"name already taken"},` +
+			`{"field":"Tag","message":"length must be at least 4"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = cli.FromCompute().CloudServers().Create(context.Background(), NewCloudServer().
+		InProject(URI("/projects/p")).
+		Named("web").
+		OnSubnets(URI("/projects/p/providers/Aruba.Network/vpcs/v/subnets/a"), URI("/projects/p/providers/Aruba.Network/vpcs/v/subnets/b")))
+
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Create error = %v, want ErrValidation", err)
+	}
+
+	var fields FieldErrors
+	if !errors.As(err, &fields) {
+		t.Fatalf("Create error = %v, want FieldErrors", err)
+	}
+	if len(fields) != 3 {
+		t.Fatalf("FieldErrors = %v, want 3 errors", fields)
+	}
+
+	subnets := fields.For("CloudServer.OnSubnets")
+	if len(subnets) != 1 || subnets[0].Index != 1 || subnets[0].Message != "subnet not found" {
+		t.Errorf("OnSubnets errors = %+v", subnets)
+	}
+	if got := fields.For("CloudServer.Named"); len(got) != 1 {
+		t.Errorf("Named errors = %+v", got)
+	}
+	if fields[2].Setter != "" || fields[2].Error() != "Tag: length must be at least 4" {
+		t.Errorf("unmapped error = %+v (%q)", fields[2], fields[2].Error())
+	}
+	if got := fields[0].Error(); got != "CloudServer.OnSubnets[1]: subnet not found" {
+		t.Errorf("FieldError.Error() = %q", got)
+	}
+}
+
+func TestList_FieldErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"title":"One or more validation error occurred.","status":400,"errors":[` +
+			`{"field":"filter","message":"unknown field"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = cli.FromCompute().CloudServers().List(context.Background(), URI("/projects/p"))
+
+	var fields FieldErrors
+	if !errors.As(err, &fields) {
+		t.Fatalf("List error = %v, want FieldErrors", err)
+	}
+	if len(fields) != 1 || fields[0].Setter != "" || fields[0].Error() != "filter: unknown field" {
+		t.Errorf("FieldErrors = %+v", fields)
+	}
+}
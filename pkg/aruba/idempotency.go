@@ -0,0 +1,133 @@
+package aruba
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+	"github.com/Arubacloud/sdk-go/internal/restclient"
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+// recoverable is satisfied by the wrappers rebuilt from their wire response.
+// Only the ones also exposing a name (and possibly tags) can be recognized,
+// hence recovered.
+type recoverable[R any] interface {
+	Wrapper
+	Raw() *R
+}
+
+type (
+	named  interface{ Name() string }
+	tagged interface{ Tags() []string }
+)
+
+// nameAndTags returns the name and tags identifying a wrapper, with ok false
+// when it has no name.
+func nameAndTags(w any) (name string, tags []string, ok bool) {
+	n, isNamed := w.(named)
+	if !isNamed || n.Name() == "" {
+		return "", nil, false
+	}
+
+	if t, isTagged := w.(tagged); isTagged {
+		tags = t.Tags()
+	}
+
+	return n.Name(), tags, true
+}
+
+// createWithRecovery sends a creation through create. When the call carries
+// an idempotency key and fails ambiguously — the API may have created the
+// resource although no successful response was received — it looks for a
+// resource with the same name and tags in the parent of w. The existing
+// resource is returned as if it had just been created; the creation is
+// re-sent, with the same key, only when none is found.
+//
+// The lookup needs a live context: a creation failing because the context
+// itself expired is not recovered.
+func createWithRecovery[W recoverable[R], R any](
+	ctx context.Context,
+	rest *restclient.Client,
+	rp *types.RequestParameters,
+	w W,
+	list func(ctx context.Context, parent Ref, opts ...CallOption) (*List[W], error),
+	create func(ctx context.Context) (*types.Response[R], error),
+) (*types.Response[R], error) {
+	resp, err := create(ctx)
+	if rp.IdempotencyKey == nil || ctx.Err() != nil || !isAmbiguousCreate(resp, err) {
+		return resp, err
+	}
+
+	name, tags, ok := nameAndTags(w)
+	if !ok {
+		return resp, err
+	}
+
+	log := rest.StructuredLogger()
+	fields := []logger.Field{logger.F("name", name), logger.F("idempotency_key", *rp.IdempotencyKey)}
+
+	existing, found, lookupErr := findCreated(ctx, w, name, tags, list)
+	if lookupErr != nil {
+		log.Log(ctx, logger.LevelWarn, "Create recovery lookup failed", append(fields, logger.F(logger.KeyError, lookupErr))...)
+		return resp, err
+	}
+
+	if found {
+		log.Log(ctx, logger.LevelInfo, "Create recovered an existing resource", append(fields, logger.F(logger.KeyResourceID, existing.ID()))...)
+		return &types.Response[R]{StatusCode: http.StatusOK, Data: existing.Raw()}, nil
+	}
+
+	log.Log(ctx, logger.LevelInfo, "Create not applied, re-sending it", fields...)
+
+	return create(ctx)
+}
+
+// isAmbiguousCreate reports whether the outcome of a creation leaves it
+// unknown whether the resource was created: the request was sent but no
+// response was received, or a server error or gateway failure was returned.
+func isAmbiguousCreate[R any](resp *types.Response[R], err error) bool {
+	if err != nil {
+		return restclient.IsAmbiguous(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// findCreated looks for a resource with the given name and carrying at least
+// the given tags, in the parent of w.
+func findCreated[W recoverable[R], R any](ctx context.Context, w W, name string, tags []string, list func(ctx context.Context, parent Ref, opts ...CallOption) (*List[W], error)) (W, bool, error) {
+	var (
+		existing W
+		found    bool
+	)
+
+	page, err := list(ctx, w)
+	if err != nil {
+		return existing, false, err
+	}
+
+	err = page.All(ctx, func(item W) bool {
+		itemName, itemTags, _ := nameAndTags(item)
+		if itemName != name || item.Raw() == nil {
+			return true
+		}
+
+		for _, tag := range tags {
+			if !slices.Contains(itemTags, tag) {
+				return true
+			}
+		}
+
+		existing, found = item, true
+		return false
+	})
+
+	return existing, found, err
+}
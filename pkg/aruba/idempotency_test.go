@@ -0,0 +1,134 @@
+package aruba
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const idempotencyTestVPCJSON = `{"metadata":{"id":"vid","name":"my-vpc","uri":"/projects/p/providers/Aruba.Network/vpcs/vid","tags":["env:prod","team:net"]},"properties":{"default":false},"status":{"state":"Creating"}}`
+
+// newFlakyCreateServer serves a VPC collection whose first POST fails with a
+// 504 Gateway Timeout, after creating the VPC when applied is true. It
+// records the idempotency keys of the POST requests.
+func newFlakyCreateServer(t *testing.T, applied bool) (cli Client, keys *[]string) {
+	t.Helper()
+
+	created := false
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			received = append(received, r.Header.Get("Idempotency-Key"))
+			if len(received) == 1 {
+				created = applied
+				w.WriteHeader(http.StatusGatewayTimeout)
+				return
+			}
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, idempotencyTestVPCJSON)
+		case http.MethodGet:
+			values := `{"metadata":{"id":"other","name":"other-vpc","uri":"/projects/p/providers/Aruba.Network/vpcs/other"}}`
+			if created {
+				values += "," + idempotencyTestVPCJSON
+			}
+			fmt.Fprintf(w, `{"total":2,"values":[%s]}`, values)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithIdempotencyKeys())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	return cli, &received
+}
+
+func TestCreate_RecoversAppliedCreation(t *testing.T) {
+	cli, keys := newFlakyCreateServer(t, true)
+
+	vpc, err := cli.FromNetwork().VPCs().Create(context.Background(),
+		NewVPC().InProject(URI("/projects/p")).Named("my-vpc").Tagged("env:prod"))
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if vpc.ID() != "vid" {
+		t.Errorf("ID() = %q, want the recovered VPC", vpc.ID())
+	}
+	if len(*keys) != 1 || (*keys)[0] == "" {
+		t.Errorf("POST idempotency keys = %q, want a single keyed POST", *keys)
+	}
+}
+
+func TestCreate_ResendsLostCreationWithSameKey(t *testing.T) {
+	cli, keys := newFlakyCreateServer(t, false)
+
+	vpc, err := cli.FromNetwork().VPCs().Create(context.Background(),
+		NewVPC().InProject(URI("/projects/p")).Named("my-vpc"))
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if vpc.ID() != "vid" || vpc.StatusCode() != http.StatusCreated {
+		t.Errorf("ID() = %q, StatusCode() = %d, want the VPC created by the second POST", vpc.ID(), vpc.StatusCode())
+	}
+	if len(*keys) != 2 || (*keys)[0] == "" || (*keys)[0] != (*keys)[1] {
+		t.Errorf("POST idempotency keys = %q, want two POSTs with the same key", *keys)
+	}
+}
+
+func TestCreate_NoRecoveryWithoutKey(t *testing.T) {
+	var posts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected %s request", r.Method)
+		}
+		posts++
+		w.WriteHeader(http.StatusGatewayTimeout)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = cli.FromNetwork().VPCs().Create(context.Background(), NewVPC().InProject(URI("/projects/p")).Named("my-vpc"))
+	if err == nil {
+		t.Fatal("Create: expected the 504 error")
+	}
+	if posts != 1 {
+		t.Errorf("POSTs = %d, want 1", posts)
+	}
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	var key string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get("Idempotency-Key")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, idempotencyTestVPCJSON)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(NewOptions().WithBaseURL(srv.URL).WithToken("test-token").WithIdempotencyKeys())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := cli.FromNetwork().VPCs().Create(context.Background(),
+		NewVPC().InProject(URI("/projects/p")).Named("my-vpc"), WithIdempotencyKey("my-key")); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if key != "my-key" {
+		t.Errorf("Idempotency-Key = %q, want %q", key, "my-key")
+	}
+}
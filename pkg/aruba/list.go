@@ -150,7 +150,8 @@ func (l *List[T]) Cursor() (next, prev string) {
 // containing pagination metadata and the typed Values slice. Cast to the
 // concrete *types.XxxList type to inspect fields not promoted to wrappers.
 // HTTP envelope (status, headers, raw body, error envelope) is exposed via
-// StatusCode(), Headers(), RawHTTP(), RawError() on the same list value.
+// StatusCode(), Headers(), RawHTTP(), RawError(), FromCache() on the same list
+// value.
 func (l *List[T]) Raw() any { return l.raw }
 
 // RawJSON returns the wire payload marshaled as JSON, or nil if the list has
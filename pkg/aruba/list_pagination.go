@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
 	"github.com/Arubacloud/sdk-go/internal/restclient"
 	"github.com/Arubacloud/sdk-go/pkg/types"
 )
@@ -17,13 +19,16 @@ func listPageFetch[L any](rest *restclient.Client, opts []CallOption) func(ctx c
 			return nil, fmt.Errorf("pagination unavailable: REST client is not initialised")
 		}
 		co := applyCallOptions(opts)
+		ctx = co.bind(ctx)
 		rp := co.toRequestParameters()
 		// The server-supplied pagination URL is authoritative; do not re-append
 		// the original query params (limit, offset, filter, api-version, …) as
 		// they are already baked into the link and duplicating them can produce
 		// conflicting or repeated query keys. Only forward request-level headers
 		// (e.g. Accept) that are not part of the URL.
+		start := time.Now()
 		httpResp, err := rest.DoRequestAbs(ctx, http.MethodGet, absURL, nil, nil, rp.ToHeaders())
+		tracePageFetched(ctx, rest, absURL, httpResp, err, start)
 		if err != nil {
 			return nil, err
 		}
@@ -31,3 +36,18 @@ func listPageFetch[L any](rest *restclient.Client, opts []CallOption) func(ctx c
 		return types.ParseResponseBody[L](httpResp, rest.Logger())
 	}
 }
+
+// tracePageFetched reports the fetch of a page to the PageFetched hook.
+func tracePageFetched(ctx context.Context, rest *restclient.Client, absURL string, resp *http.Response, err error, start time.Time) {
+	hook := rest.ClientTrace().PageFetched
+	if hook == nil {
+		return
+	}
+
+	info := clienttrace.PageInfo{URL: absURL, Err: err, Latency: time.Since(start)}
+	if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	hook(ctx, info)
+}
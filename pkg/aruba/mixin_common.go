@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/Arubacloud/sdk-go/internal/restclient"
 	"github.com/Arubacloud/sdk-go/pkg/types"
 )
 
@@ -252,6 +253,7 @@ type httpEnvelopeMixin struct {
 	rawBody    []byte
 	httpResp   *http.Response
 	errResp    *types.ErrorResponse
+	fromCache  bool
 }
 
 // populateHTTPEnvelope fills an httpEnvelopeMixin from a typed *types.Response[T].
@@ -265,6 +267,7 @@ func populateHTTPEnvelope[T any](m *httpEnvelopeMixin, resp *types.Response[T])
 	m.rawBody = resp.RawBody
 	m.httpResp = resp.HTTPResponse
 	m.errResp = resp.Error
+	m.fromCache = restclient.IsFromCache(resp.HTTPResponse)
 }
 
 // StatusCode returns the HTTP status code, or 0 before any response.
@@ -278,5 +281,9 @@ func (m *httpEnvelopeMixin) RawHTTP() (*http.Response, []byte) {
 	return m.httpResp, m.rawBody
 }
 
+// FromCache reports whether the response was served from the response cache
+// (see Options.WithResponseCache) because the API replied 304 Not Modified.
+func (m *httpEnvelopeMixin) FromCache() bool { return m.fromCache }
+
 // RawError returns the parsed error response body for non-2xx replies, or nil.
 func (m *httpEnvelopeMixin) RawError() *types.ErrorResponse { return m.errResp }
@@ -275,6 +275,25 @@ func TestHTTPEnvelopeMixin(t *testing.T) {
 	}
 }
 
+func TestHTTPEnvelopeMixin_FromCache(t *testing.T) {
+	var m httpEnvelopeMixin
+	populateHTTPEnvelope(&m, &types.Response[struct{}]{
+		StatusCode:   200,
+		HTTPResponse: &http.Response{StatusCode: 200, Header: http.Header{"X-From-Cache": []string{"1"}}},
+	})
+	if !m.FromCache() {
+		t.Error("FromCache() = false, want true for a response served from cache")
+	}
+
+	populateHTTPEnvelope(&m, &types.Response[struct{}]{
+		StatusCode:   200,
+		HTTPResponse: &http.Response{StatusCode: 200, Header: http.Header{}},
+	})
+	if m.FromCache() {
+		t.Error("FromCache() = true, want false for a fresh response")
+	}
+}
+
 func TestHTTPEnvelopeMixin_NilResponse(t *testing.T) {
 	var m httpEnvelopeMixin
 	populateHTTPEnvelope[struct{}](&m, nil)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/Arubacloud/sdk-go/internal/restclient"
 	"github.com/Arubacloud/sdk-go/pkg/async"
 	"github.com/Arubacloud/sdk-go/pkg/types"
 )
@@ -18,9 +19,11 @@ import (
 // that support polling but carry no lifecycle State.
 type refreshMixin struct {
 	refresh func(ctx context.Context) error
+	rest    *restclient.Client // traces and reports polling ticks; may be nil
 }
 
 func (m *refreshMixin) setRefresh(fn func(context.Context) error) { m.refresh = fn }
+func (m *refreshMixin) setRESTClient(rest *restclient.Client)     { m.rest = rest }
 
 // WaitUntilGone blocks until the resource no longer exists — that is, until a
 // refresh (Get) returns HTTP 404. Use it after Delete to wait for teardown to
@@ -32,16 +35,22 @@ func (m *refreshMixin) WaitUntilGone(ctx context.Context, opts ...WaitOption) er
 		return errors.New("WaitUntilGone: refresh callback not set; resource must be produced by an adapter (Create/Get/Update/List) to support polling")
 	}
 	cfg := applyWaitOptions(opts)
+	attempt := 0
 	call := func(ctx context.Context) (*types.Response[any], error) {
+		attempt++
+		ctx, t := startTick(ctx, m.rest, "WaitUntilGone", attempt, nil)
 		err := m.refresh(ctx)
 		if err == nil {
+			t.end("", false, nil)
 			return &types.Response[any]{}, nil // still exists — keep polling
 		}
 		var httpErr *HTTPError
 		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			t.end("", true, nil)
 			gone := any(struct{}{})
 			return &types.Response[any]{Data: &gone}, nil // gone
 		}
+		t.end("", false, err)
 		return nil, err // transient — retry
 	}
 	check := func(resp *types.Response[any]) (bool, error) {
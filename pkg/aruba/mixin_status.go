@@ -136,10 +136,15 @@ func (m *statusMixin) WaitUntilStates(ctx context.Context, targets []types.State
 		return errors.New("WaitUntilStates: refresh callback not set; resource must be produced by an adapter (Create/Get/Update/List) to support polling")
 	}
 	cfg := applyWaitOptions(opts)
+	attempt := 0
 	call := func(ctx context.Context) (*types.Response[any], error) {
+		attempt++
+		ctx, t := startTick(ctx, m.rest, "WaitUntilStates", attempt, targets)
 		if err := m.refresh(ctx); err != nil {
+			t.end("", false, err)
 			return nil, err
 		}
+		t.end(m.State(), false, nil)
 		return &types.Response[any]{}, nil
 	}
 	var terminalErr error
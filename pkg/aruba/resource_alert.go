@@ -287,19 +287,23 @@ func newAlertsClientAdapter(rest *restclient.Client) *alertsClientAdapter {
 }
 
 // List returns a paginated list of Alert in the given parent scope.
-func (a *alertsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*Alert], error) {
+func (a *alertsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*Alert], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "AlertsClient.List", "Alert", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Alert
 	if resp != nil && resp.Data != nil {
@@ -320,7 +324,7 @@ func (a *alertsClientAdapter) List(ctx context.Context, project Ref, opts ...Cal
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Alert
 		if pageResp != nil && pageResp.Data != nil {
@@ -246,19 +246,23 @@ func newAuditEventsClientAdapter(rest *restclient.Client) *auditEventsClientAdap
 }
 
 // List returns a paginated list of AuditEvent in the given parent scope.
-func (a *auditEventsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*AuditEvent], error) {
+func (a *auditEventsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*AuditEvent], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "EventsClient.List", "AuditEvent", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*AuditEvent
 	if resp != nil && resp.Data != nil {
@@ -279,7 +283,7 @@ func (a *auditEventsClientAdapter) List(ctx context.Context, project Ref, opts .
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*AuditEvent
 		if pageResp != nil && pageResp.Data != nil {
@@ -229,6 +229,17 @@ func (b *BlockStorage) toUpdateRequest() types.BlockStorageRequest {
 	}
 }
 
+// blockStorageFieldSetters maps the wire paths of the BlockStorage request to its setters.
+var blockStorageFieldSetters = regionalFieldSetters("BlockStorage", map[string]string{
+	"properties.sizeGb":        "SizedGB",
+	"properties.billingPeriod": "BilledBy",
+	"properties.dataCenter":    "InZone",
+	"properties.type":          "OfType",
+	"properties.snapshot":      "FromSnapshot",
+	"properties.bootable":      "AsBootable",
+	"properties.image":         "FromImage",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (b *BlockStorage) fromResponse(resp *types.BlockStorageResponse) {
 	if resp == nil {
@@ -351,7 +362,10 @@ func newVolumesClientAdapter(rest *restclient.Client) *volumesClientAdapter {
 }
 
 // Create posts a new BlockStorage to the API and hydrates the wrapper from the response.
-func (a *volumesClientAdapter) Create(ctx context.Context, vol *BlockStorage, opts ...CallOption) (*BlockStorage, error) {
+func (a *volumesClientAdapter) Create(ctx context.Context, vol *BlockStorage, opts ...CallOption) (_ *BlockStorage, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VolumesClient.Create", "BlockStorage", vol)
+	defer func() { op.end(err) }()
+
 	if err := vol.Err(); err != nil {
 		return vol, err
 	}
@@ -359,11 +373,15 @@ func (a *volumesClientAdapter) Create(ctx context.Context, vol *BlockStorage, op
 		return vol, fmt.Errorf("Create: BlockStorage has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, vol.ProjectID(), vol.toCreateRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, vol, a.List, func(ctx context.Context) (*types.Response[types.BlockStorageResponse], error) {
+		return a.low.Create(ctx, vol.ProjectID(), vol.toCreateRequest(), rp)
+	})
 	populateHTTPEnvelope(&vol.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		vol.fromResponse(resp.Data)
+		vol.setRESTClient(a.rest)
 		vol.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, vol)
 			if err != nil {
@@ -379,24 +397,29 @@ func (a *volumesClientAdapter) Create(ctx context.Context, vol *BlockStorage, op
 		return vol, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return vol, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return vol, newHTTPError(resp, blockStorageFieldSetters)
 	}
 	return vol, nil
 }
 
 // Get fetches a BlockStorage by Ref and returns a freshly hydrated wrapper.
-func (a *volumesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*BlockStorage, error) {
+func (a *volumesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *BlockStorage, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VolumesClient.Get", "BlockStorage", ref)
+	defer func() { op.end(err) }()
+
 	projectID, blockStorageID, err := blockStorageIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, blockStorageID, rp)
 	out := &BlockStorage{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -415,13 +438,16 @@ func (a *volumesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOpt
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *volumesClientAdapter) Update(ctx context.Context, vol *BlockStorage, opts ...CallOption) (*BlockStorage, error) {
+func (a *volumesClientAdapter) Update(ctx context.Context, vol *BlockStorage, opts ...CallOption) (_ *BlockStorage, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VolumesClient.Update", "BlockStorage", vol)
+	defer func() { op.end(err) }()
+
 	if err := vol.Err(); err != nil {
 		return vol, err
 	}
@@ -432,11 +458,13 @@ func (a *volumesClientAdapter) Update(ctx context.Context, vol *BlockStorage, op
 		return vol, fmt.Errorf("Update: BlockStorage has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(vol.Version())
 	resp, err := a.low.Update(ctx, vol.ProjectID(), vol.ID(), vol.toUpdateRequest(), rp)
 	populateHTTPEnvelope(&vol.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		vol.fromResponse(resp.Data)
+		vol.setRESTClient(a.rest)
 		vol.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, vol)
 			if err != nil {
@@ -452,43 +480,51 @@ func (a *volumesClientAdapter) Update(ctx context.Context, vol *BlockStorage, op
 		return vol, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return vol, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return vol, newUpdateError(resp, rp, blockStorageFieldSetters)
 	}
 	return vol, nil
 }
 
 // Delete removes the BlockStorage identified by Ref.
-func (a *volumesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *volumesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "VolumesClient.Delete", "BlockStorage", ref)
+	defer func() { op.end(err) }()
+
 	projectID, blockStorageID, err := blockStorageIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, blockStorageID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of BlockStorage in the given parent scope.
-func (a *volumesClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*BlockStorage], error) {
+func (a *volumesClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*BlockStorage], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "VolumesClient.List", "BlockStorage", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*BlockStorage
 	if resp != nil && resp.Data != nil {
@@ -496,6 +532,7 @@ func (a *volumesClientAdapter) List(ctx context.Context, project Ref, opts ...Ca
 		for i := range resp.Data.Values {
 			bs := &BlockStorage{}
 			bs.fromResponse(&resp.Data.Values[i])
+			bs.setRESTClient(a.rest)
 			bs.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, bs)
 				if err != nil {
@@ -520,7 +557,7 @@ func (a *volumesClientAdapter) List(ctx context.Context, project Ref, opts ...Ca
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*BlockStorage
 		if pageResp != nil && pageResp.Data != nil {
@@ -528,6 +565,7 @@ func (a *volumesClientAdapter) List(ctx context.Context, project Ref, opts ...Ca
 			for i := range pageResp.Data.Values {
 				bs := &BlockStorage{}
 				bs.fromResponse(&pageResp.Data.Values[i])
+				bs.setRESTClient(a.rest)
 				bs.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, bs)
 					if err != nil {
@@ -331,6 +331,7 @@ func (cs *CloudServer) PowerOn(ctx context.Context, opts ...CallOption) error {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := cs.actions.powerOn(ctx, cs.ProjectID(), cs.CloudServerID(), rp)
 	populateHTTPEnvelope(&cs.httpEnvelopeMixin, resp)
@@ -341,7 +342,7 @@ func (cs *CloudServer) PowerOn(ctx context.Context, opts ...CallOption) error {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
@@ -352,6 +353,7 @@ func (cs *CloudServer) PowerOff(ctx context.Context, opts ...CallOption) error {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := cs.actions.powerOff(ctx, cs.ProjectID(), cs.CloudServerID(), rp)
 	populateHTTPEnvelope(&cs.httpEnvelopeMixin, resp)
@@ -362,7 +364,7 @@ func (cs *CloudServer) PowerOff(ctx context.Context, opts ...CallOption) error {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
@@ -373,6 +375,7 @@ func (cs *CloudServer) SetPassword(ctx context.Context, password string, opts ..
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := cs.actions.setPassword(ctx, cs.ProjectID(), cs.CloudServerID(), password, rp)
 	populateHTTPEnvelope(&cs.httpEnvelopeMixin, resp)
@@ -380,7 +383,7 @@ func (cs *CloudServer) SetPassword(ctx context.Context, password string, opts ..
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
@@ -448,6 +451,21 @@ func (cs *CloudServer) toRequest() types.CloudServerRequest {
 	}
 }
 
+// cloudServerFieldSetters maps the wire paths of the CloudServer request to its setters.
+var cloudServerFieldSetters = regionalFieldSetters("CloudServer", map[string]string{
+	"properties.dataCenter":     "InZone",
+	"properties.vpc":            "WithVPC",
+	"properties.vpcPreset":      "WithVPCPreset",
+	"properties.flavorName":     "OfFlavor",
+	"properties.elasticIp":      "WithElasticIP",
+	"properties.bootVolume":     "BootingFrom",
+	"properties.keyPair":        "UsingKeyPair",
+	"properties.subnets":        "OnSubnets",
+	"properties.securityGroups": "WithSecurityGroups",
+	"properties.userData":       "WithUserData",
+	"properties.billingPlan":    "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (cs *CloudServer) fromResponse(resp *types.CloudServerResponse) {
 	if resp == nil {
@@ -560,7 +578,10 @@ func newCloudServersClientAdapter(rest *restclient.Client) *cloudServersClientAd
 }
 
 // Create posts a new CloudServer to the API and hydrates the wrapper from the response.
-func (a *cloudServersClientAdapter) Create(ctx context.Context, cs *CloudServer, opts ...CallOption) (*CloudServer, error) {
+func (a *cloudServersClientAdapter) Create(ctx context.Context, cs *CloudServer, opts ...CallOption) (_ *CloudServer, err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServersClient.Create", "CloudServer", cs)
+	defer func() { op.end(err) }()
+
 	if err := cs.Err(); err != nil {
 		return cs, err
 	}
@@ -568,11 +589,15 @@ func (a *cloudServersClientAdapter) Create(ctx context.Context, cs *CloudServer,
 		return cs, fmt.Errorf("Create: CloudServer has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, cs.ProjectID(), cs.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, cs, a.List, func(ctx context.Context) (*types.Response[types.CloudServerResponse], error) {
+		return a.low.Create(ctx, cs.ProjectID(), cs.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&cs.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		cs.fromResponse(resp.Data)
+		cs.setRESTClient(a.rest)
 		cs.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, cs)
 			if err != nil {
@@ -589,13 +614,16 @@ func (a *cloudServersClientAdapter) Create(ctx context.Context, cs *CloudServer,
 		return cs, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return cs, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return cs, newHTTPError(resp, cloudServerFieldSetters)
 	}
 	return cs, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *cloudServersClientAdapter) Update(ctx context.Context, cs *CloudServer, opts ...CallOption) (*CloudServer, error) {
+func (a *cloudServersClientAdapter) Update(ctx context.Context, cs *CloudServer, opts ...CallOption) (_ *CloudServer, err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServersClient.Update", "CloudServer", cs)
+	defer func() { op.end(err) }()
+
 	if err := cs.Err(); err != nil {
 		return cs, err
 	}
@@ -606,11 +634,13 @@ func (a *cloudServersClientAdapter) Update(ctx context.Context, cs *CloudServer,
 		return cs, fmt.Errorf("Update: CloudServer has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(cs.Version())
 	resp, err := a.low.Update(ctx, cs.ProjectID(), cs.CloudServerID(), cs.toRequest(), rp)
 	populateHTTPEnvelope(&cs.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		cs.fromResponse(resp.Data)
+		cs.setRESTClient(a.rest)
 		cs.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, cs)
 			if err != nil {
@@ -627,24 +657,29 @@ func (a *cloudServersClientAdapter) Update(ctx context.Context, cs *CloudServer,
 		return cs, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return cs, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return cs, newUpdateError(resp, rp, cloudServerFieldSetters)
 	}
 	return cs, nil
 }
 
 // Get fetches a CloudServer by Ref and returns a freshly hydrated wrapper.
-func (a *cloudServersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*CloudServer, error) {
+func (a *cloudServersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *CloudServer, err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServersClient.Get", "CloudServer", ref)
+	defer func() { op.end(err) }()
+
 	projectID, cloudServerID, err := cloudServerIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, cloudServerID, rp)
 	out := &CloudServer{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -664,43 +699,51 @@ func (a *cloudServersClientAdapter) Get(ctx context.Context, ref Ref, opts ...Ca
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the CloudServer identified by Ref.
-func (a *cloudServersClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *cloudServersClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServersClient.Delete", "CloudServer", ref)
+	defer func() { op.end(err) }()
+
 	projectID, cloudServerID, err := cloudServerIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, cloudServerID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of CloudServer in the given parent scope.
-func (a *cloudServersClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*CloudServer], error) {
+func (a *cloudServersClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*CloudServer], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "CloudServersClient.List", "CloudServer", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*CloudServer
 	if resp != nil && resp.Data != nil {
@@ -708,6 +751,7 @@ func (a *cloudServersClientAdapter) List(ctx context.Context, project Ref, opts
 		for i := range resp.Data.Values {
 			cs := &CloudServer{}
 			cs.fromResponse(&resp.Data.Values[i])
+			cs.setRESTClient(a.rest)
 			cs.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, cs)
 				if err != nil {
@@ -733,7 +777,7 @@ func (a *cloudServersClientAdapter) List(ctx context.Context, project Ref, opts
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*CloudServer
 		if pageResp != nil && pageResp.Data != nil {
@@ -741,6 +785,7 @@ func (a *cloudServersClientAdapter) List(ctx context.Context, project Ref, opts
 			for i := range pageResp.Data.Values {
 				cs := &CloudServer{}
 				cs.fromResponse(&pageResp.Data.Values[i])
+				cs.setRESTClient(a.rest)
 				cs.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, cs)
 					if err != nil {
@@ -766,17 +811,26 @@ func (a *cloudServersClientAdapter) List(ctx context.Context, project Ref, opts
 // Internal action methods — satisfy cloudServerActions; called by *CloudServer action methods.
 
 // powerOn sends a power-on action to the API for the given server.
-func (a *cloudServersClientAdapter) powerOn(ctx context.Context, projectID, cloudServerID string, rp *types.RequestParameters) (*types.Response[types.CloudServerResponse], error) {
+func (a *cloudServersClientAdapter) powerOn(ctx context.Context, projectID, cloudServerID string, rp *types.RequestParameters) (resp *types.Response[types.CloudServerResponse], err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServer.PowerOn", "CloudServer", idRef{projectID: projectID, id: cloudServerID})
+	defer func() { endAction(op, resp, err) }()
+
 	return a.low.PowerOn(ctx, projectID, cloudServerID, rp)
 }
 
 // powerOff sends a power-off action to the API for the given server.
-func (a *cloudServersClientAdapter) powerOff(ctx context.Context, projectID, cloudServerID string, rp *types.RequestParameters) (*types.Response[types.CloudServerResponse], error) {
+func (a *cloudServersClientAdapter) powerOff(ctx context.Context, projectID, cloudServerID string, rp *types.RequestParameters) (resp *types.Response[types.CloudServerResponse], err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServer.PowerOff", "CloudServer", idRef{projectID: projectID, id: cloudServerID})
+	defer func() { endAction(op, resp, err) }()
+
 	return a.low.PowerOff(ctx, projectID, cloudServerID, rp)
 }
 
 // setPassword sends a set-password action to the API for the given server.
-func (a *cloudServersClientAdapter) setPassword(ctx context.Context, projectID, cloudServerID, password string, rp *types.RequestParameters) (*types.Response[any], error) {
+func (a *cloudServersClientAdapter) setPassword(ctx context.Context, projectID, cloudServerID, password string, rp *types.RequestParameters) (resp *types.Response[any], err error) {
+	ctx, op := startOperation(ctx, a.rest, "CloudServer.SetPassword", "CloudServer", idRef{projectID: projectID, id: cloudServerID})
+	defer func() { endAction(op, resp, err) }()
+
 	return a.low.SetPassword(ctx, projectID, cloudServerID, types.CloudServerPasswordRequest{Password: password}, rp)
 }
 
@@ -324,6 +324,18 @@ func (r *ContainerRegistry) toRequest() types.ContainerRegistryRequest {
 	}
 }
 
+// containerRegistryFieldSetters maps the wire paths of the ContainerRegistry request to its setters.
+var containerRegistryFieldSetters = regionalFieldSetters("ContainerRegistry", map[string]string{
+	"properties.publicIp":      "WithElasticIP",
+	"properties.vpc":           "WithVPC",
+	"properties.subnet":        "WithSubnet",
+	"properties.securityGroup": "WithSecurityGroup",
+	"properties.blockStorage":  "WithBlockStorage",
+	"properties.adminUser":     "WithAdminUsername",
+	"properties.size":          "OfSize",
+	"properties.billingPlan":   "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (r *ContainerRegistry) fromResponse(resp *types.ContainerRegistryResponse) {
 	if resp == nil {
@@ -443,7 +455,10 @@ func newContainerRegistriesClientAdapter(rest *restclient.Client) *containerRegi
 }
 
 // Create posts a new ContainerRegistry to the API and hydrates the wrapper from the response.
-func (a *containerRegistriesClientAdapter) Create(ctx context.Context, r *ContainerRegistry, opts ...CallOption) (*ContainerRegistry, error) {
+func (a *containerRegistriesClientAdapter) Create(ctx context.Context, r *ContainerRegistry, opts ...CallOption) (_ *ContainerRegistry, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ContainerRegistryClient.Create", "ContainerRegistry", r)
+	defer func() { op.end(err) }()
+
 	if err := r.Err(); err != nil {
 		return r, err
 	}
@@ -451,11 +466,15 @@ func (a *containerRegistriesClientAdapter) Create(ctx context.Context, r *Contai
 		return r, fmt.Errorf("Create: ContainerRegistry has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, r.ProjectID(), r.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, r, a.List, func(ctx context.Context) (*types.Response[types.ContainerRegistryResponse], error) {
+		return a.low.Create(ctx, r.ProjectID(), r.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&r.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		r.fromResponse(resp.Data)
+		r.setRESTClient(a.rest)
 		r.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, r)
 			if err != nil {
@@ -471,13 +490,16 @@ func (a *containerRegistriesClientAdapter) Create(ctx context.Context, r *Contai
 		return r, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return r, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return r, newHTTPError(resp, containerRegistryFieldSetters)
 	}
 	return r, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *containerRegistriesClientAdapter) Update(ctx context.Context, r *ContainerRegistry, opts ...CallOption) (*ContainerRegistry, error) {
+func (a *containerRegistriesClientAdapter) Update(ctx context.Context, r *ContainerRegistry, opts ...CallOption) (_ *ContainerRegistry, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ContainerRegistryClient.Update", "ContainerRegistry", r)
+	defer func() { op.end(err) }()
+
 	if err := r.Err(); err != nil {
 		return r, err
 	}
@@ -488,11 +510,13 @@ func (a *containerRegistriesClientAdapter) Update(ctx context.Context, r *Contai
 		return r, fmt.Errorf("Update: ContainerRegistry has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(r.Version())
 	resp, err := a.low.Update(ctx, r.ProjectID(), r.ContainerRegistryID(), r.toRequest(), rp)
 	populateHTTPEnvelope(&r.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		r.fromResponse(resp.Data)
+		r.setRESTClient(a.rest)
 		r.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, r)
 			if err != nil {
@@ -508,18 +532,22 @@ func (a *containerRegistriesClientAdapter) Update(ctx context.Context, r *Contai
 		return r, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return r, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return r, newUpdateError(resp, rp, containerRegistryFieldSetters)
 	}
 	return r, nil
 }
 
 // Get fetches a ContainerRegistry by Ref and returns a freshly hydrated wrapper.
-func (a *containerRegistriesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*ContainerRegistry, error) {
+func (a *containerRegistriesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *ContainerRegistry, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ContainerRegistryClient.Get", "ContainerRegistry", ref)
+	defer func() { op.end(err) }()
+
 	projectID, registryID, err := containerRegistryIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, registryID, rp)
 	out := &ContainerRegistry{}
@@ -527,6 +555,7 @@ func (a *containerRegistriesClientAdapter) Get(ctx context.Context, ref Ref, opt
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -545,43 +574,51 @@ func (a *containerRegistriesClientAdapter) Get(ctx context.Context, ref Ref, opt
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the ContainerRegistry identified by Ref.
-func (a *containerRegistriesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *containerRegistriesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "ContainerRegistryClient.Delete", "ContainerRegistry", ref)
+	defer func() { op.end(err) }()
+
 	projectID, registryID, err := containerRegistryIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, registryID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of ContainerRegistry in the given parent scope.
-func (a *containerRegistriesClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*ContainerRegistry], error) {
+func (a *containerRegistriesClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*ContainerRegistry], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "ContainerRegistryClient.List", "ContainerRegistry", parent)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*ContainerRegistry
 	if resp != nil && resp.Data != nil {
@@ -590,6 +627,7 @@ func (a *containerRegistriesClientAdapter) List(ctx context.Context, parent Ref,
 			cr := &ContainerRegistry{}
 			cr.projectID = projectID
 			cr.fromResponse(&resp.Data.Values[i])
+			cr.setRESTClient(a.rest)
 			cr.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, cr)
 				if err != nil {
@@ -614,7 +652,7 @@ func (a *containerRegistriesClientAdapter) List(ctx context.Context, parent Ref,
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*ContainerRegistry
 		if pageResp != nil && pageResp.Data != nil {
@@ -623,6 +661,7 @@ func (a *containerRegistriesClientAdapter) List(ctx context.Context, parent Ref,
 				cr := &ContainerRegistry{}
 				cr.projectID = projectID
 				cr.fromResponse(&pageResp.Data.Values[i])
+				cr.setRESTClient(a.rest)
 				cr.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, cr)
 					if err != nil {
@@ -111,6 +111,11 @@ func (d *Database) toRequest() types.DatabaseRequest {
 	return types.DatabaseRequest{Name: dbDerefString(d.name)}
 }
 
+// databaseFieldSetters maps the wire paths of the Database request to its setters.
+var databaseFieldSetters = newFieldSetters("Database", map[string]string{
+	"name": "Named",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (d *Database) fromResponse(resp *types.DatabaseResponse) {
 	if resp == nil {
@@ -162,7 +167,10 @@ func newDatabasesClientAdapter(rest *restclient.Client) *databasesClientAdapter
 }
 
 // Create posts a new Database to the API and hydrates the wrapper from the response.
-func (a *databasesClientAdapter) Create(ctx context.Context, db *Database, opts ...CallOption) (*Database, error) {
+func (a *databasesClientAdapter) Create(ctx context.Context, db *Database, opts ...CallOption) (_ *Database, err error) {
+	ctx, op := startOperation(ctx, a.rest, "DatabasesClient.Create", "Database", db)
+	defer func() { op.end(err) }()
+
 	if err := db.Err(); err != nil {
 		return db, err
 	}
@@ -176,11 +184,15 @@ func (a *databasesClientAdapter) Create(ctx context.Context, db *Database, opts
 		return db, fmt.Errorf("Create: Database has no name — call Named first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, db.ProjectID(), db.DBaaSID(), db.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, db, a.List, func(ctx context.Context) (*types.Response[types.DatabaseResponse], error) {
+		return a.low.Create(ctx, db.ProjectID(), db.DBaaSID(), db.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&db.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		db.fromResponse(resp.Data)
+		db.setRESTClient(a.rest)
 		db.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, db)
 			if err != nil {
@@ -196,13 +208,16 @@ func (a *databasesClientAdapter) Create(ctx context.Context, db *Database, opts
 		return db, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return db, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return db, newHTTPError(resp, databaseFieldSetters)
 	}
 	return db, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *databasesClientAdapter) Update(ctx context.Context, db *Database, opts ...CallOption) (*Database, error) {
+func (a *databasesClientAdapter) Update(ctx context.Context, db *Database, opts ...CallOption) (_ *Database, err error) {
+	ctx, op := startOperation(ctx, a.rest, "DatabasesClient.Update", "Database", db)
+	defer func() { op.end(err) }()
+
 	if err := db.Err(); err != nil {
 		return db, err
 	}
@@ -216,11 +231,13 @@ func (a *databasesClientAdapter) Update(ctx context.Context, db *Database, opts
 		return db, fmt.Errorf("Update: Database has no parent project — call InDBaaS first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(db.Version())
 	resp, err := a.low.Update(ctx, db.ProjectID(), db.DBaaSID(), db.DatabaseID(), db.toRequest(), rp)
 	populateHTTPEnvelope(&db.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		db.fromResponse(resp.Data)
+		db.setRESTClient(a.rest)
 		db.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, db)
 			if err != nil {
@@ -236,18 +253,22 @@ func (a *databasesClientAdapter) Update(ctx context.Context, db *Database, opts
 		return db, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return db, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return db, newUpdateError(resp, rp, databaseFieldSetters)
 	}
 	return db, nil
 }
 
 // Get fetches a Database by Ref and returns a freshly hydrated wrapper.
-func (a *databasesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Database, error) {
+func (a *databasesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Database, err error) {
+	ctx, op := startOperation(ctx, a.rest, "DatabasesClient.Get", "Database", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, databaseID, err := databaseIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, dbaasID, databaseID, rp)
 	out := &Database{}
@@ -258,6 +279,7 @@ func (a *databasesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallO
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -273,43 +295,51 @@ func (a *databasesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallO
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the Database identified by Ref.
-func (a *databasesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *databasesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "DatabasesClient.Delete", "Database", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, databaseID, err := databaseIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, dbaasID, databaseID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Database in the given parent scope.
-func (a *databasesClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOption) (*List[*Database], error) {
+func (a *databasesClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOption) (_ *List[*Database], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "DatabasesClient.List", "Database", dbaas)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, err := dbaasIDsFromRef(dbaas)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, dbaasID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Database
 	if resp != nil && resp.Data != nil {
@@ -319,6 +349,7 @@ func (a *databasesClientAdapter) List(ctx context.Context, dbaas Ref, opts ...Ca
 			db.dbaasID = dbaasID
 			db.projectID = projectID
 			db.fromResponse(&resp.Data.Values[i])
+			db.setRESTClient(a.rest)
 			db.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, db)
 				if err != nil {
@@ -340,7 +371,7 @@ func (a *databasesClientAdapter) List(ctx context.Context, dbaas Ref, opts ...Ca
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Database
 		if pageResp != nil && pageResp.Data != nil {
@@ -350,6 +381,7 @@ func (a *databasesClientAdapter) List(ctx context.Context, dbaas Ref, opts ...Ca
 				db.dbaasID = dbaasID
 				db.projectID = projectID
 				db.fromResponse(&pageResp.Data.Values[i])
+				db.setRESTClient(a.rest)
 				db.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, db)
 					if err != nil {
@@ -430,6 +430,20 @@ func (d *DBaaS) toRequest() types.DBaaSRequest {
 	}
 }
 
+// dbaasFieldSetters maps the wire paths of the DBaaS request to its setters.
+var dbaasFieldSetters = regionalFieldSetters("DBaaS", map[string]string{
+	"properties.dataCenter":                  "InZone",
+	"properties.engine":                      "OfEngine",
+	"properties.flavor":                      "OfFlavor",
+	"properties.storage":                     "SizedGB",
+	"properties.autoscaling":                 "WithAutoscaling",
+	"properties.billingPlan":                 "BilledBy",
+	"properties.networking.vpcUri":           "WithVPC",
+	"properties.networking.subnetUri":        "WithSubnet",
+	"properties.networking.securityGroupUri": "WithSecurityGroup",
+	"properties.networking.elasticIpUri":     "WithElasticIP",
+})
+
 // Autoscaling status wire values from the DBaaS Autoscaling response.
 // The API reports either "Enabled" or "Active" for an enabled autoscaling
 // configuration; both are treated as enabled by the wrapper.
@@ -568,7 +582,10 @@ func newDBaaSClientAdapter(rest *restclient.Client) *dbaasClientAdapter {
 }
 
 // Create posts a new DBaaS to the API and hydrates the wrapper from the response.
-func (a *dbaasClientAdapter) Create(ctx context.Context, d *DBaaS, opts ...CallOption) (*DBaaS, error) {
+func (a *dbaasClientAdapter) Create(ctx context.Context, d *DBaaS, opts ...CallOption) (_ *DBaaS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "DBaaSClient.Create", "DBaaS", d)
+	defer func() { op.end(err) }()
+
 	if err := d.Err(); err != nil {
 		return d, err
 	}
@@ -576,11 +593,15 @@ func (a *dbaasClientAdapter) Create(ctx context.Context, d *DBaaS, opts ...CallO
 		return d, fmt.Errorf("Create: DBaaS has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, d.ProjectID(), d.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, d, a.List, func(ctx context.Context) (*types.Response[types.DBaaSResponse], error) {
+		return a.low.Create(ctx, d.ProjectID(), d.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&d.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		d.fromResponse(resp.Data)
+		d.setRESTClient(a.rest)
 		d.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, d)
 			if err != nil {
@@ -596,13 +617,16 @@ func (a *dbaasClientAdapter) Create(ctx context.Context, d *DBaaS, opts ...CallO
 		return d, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return d, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return d, newHTTPError(resp, dbaasFieldSetters)
 	}
 	return d, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *dbaasClientAdapter) Update(ctx context.Context, d *DBaaS, opts ...CallOption) (*DBaaS, error) {
+func (a *dbaasClientAdapter) Update(ctx context.Context, d *DBaaS, opts ...CallOption) (_ *DBaaS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "DBaaSClient.Update", "DBaaS", d)
+	defer func() { op.end(err) }()
+
 	if err := d.Err(); err != nil {
 		return d, err
 	}
@@ -613,11 +637,13 @@ func (a *dbaasClientAdapter) Update(ctx context.Context, d *DBaaS, opts ...CallO
 		return d, fmt.Errorf("Update: DBaaS has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(d.Version())
 	resp, err := a.low.Update(ctx, d.ProjectID(), d.DBaaSID(), d.toRequest(), rp)
 	populateHTTPEnvelope(&d.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		d.fromResponse(resp.Data)
+		d.setRESTClient(a.rest)
 		d.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, d)
 			if err != nil {
@@ -633,24 +659,29 @@ func (a *dbaasClientAdapter) Update(ctx context.Context, d *DBaaS, opts ...CallO
 		return d, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return d, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return d, newUpdateError(resp, rp, dbaasFieldSetters)
 	}
 	return d, nil
 }
 
 // Get fetches a DBaaS by Ref and returns a freshly hydrated wrapper.
-func (a *dbaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*DBaaS, error) {
+func (a *dbaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *DBaaS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "DBaaSClient.Get", "DBaaS", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, err := dbaasIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, dbaasID, rp)
 	out := &DBaaS{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -669,43 +700,51 @@ func (a *dbaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOptio
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the DBaaS identified by Ref.
-func (a *dbaasClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *dbaasClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "DBaaSClient.Delete", "DBaaS", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, err := dbaasIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, dbaasID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of DBaaS instances in the given parent scope.
-func (a *dbaasClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*DBaaS], error) {
+func (a *dbaasClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*DBaaS], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "DBaaSClient.List", "DBaaS", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*DBaaS
 	if resp != nil && resp.Data != nil {
@@ -713,6 +752,7 @@ func (a *dbaasClientAdapter) List(ctx context.Context, project Ref, opts ...Call
 		for i := range resp.Data.Values {
 			d := &DBaaS{}
 			d.fromResponse(&resp.Data.Values[i])
+			d.setRESTClient(a.rest)
 			d.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, d)
 				if err != nil {
@@ -737,7 +777,7 @@ func (a *dbaasClientAdapter) List(ctx context.Context, project Ref, opts ...Call
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*DBaaS
 		if pageResp != nil && pageResp.Data != nil {
@@ -745,6 +785,7 @@ func (a *dbaasClientAdapter) List(ctx context.Context, project Ref, opts ...Call
 			for i := range pageResp.Data.Values {
 				d := &DBaaS{}
 				d.fromResponse(&pageResp.Data.Values[i])
+				d.setRESTClient(a.rest)
 				d.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, d)
 					if err != nil {
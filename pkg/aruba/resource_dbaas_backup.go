@@ -183,6 +183,14 @@ func (b *DBaaSBackup) toRequest() types.BackupRequest {
 	}
 }
 
+// dbaasBackupFieldSetters maps the wire paths of the DBaaSBackup request to its setters.
+var dbaasBackupFieldSetters = regionalFieldSetters("DBaaSBackup", map[string]string{
+	"properties.datacenter":  "InZone",
+	"properties.dbaas":       "FromDBaaS",
+	"properties.database":    "FromDatabase",
+	"properties.billingPlan": "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (b *DBaaSBackup) fromResponse(resp *types.BackupResponse) {
 	if resp == nil {
@@ -288,7 +296,10 @@ func newDBaaSBackupsClientAdapter(rest *restclient.Client) *dbaasBackupsClientAd
 }
 
 // Create posts a new DBaaSBackup to the API and hydrates the wrapper from the response.
-func (a *dbaasBackupsClientAdapter) Create(ctx context.Context, b *DBaaSBackup, opts ...CallOption) (*DBaaSBackup, error) {
+func (a *dbaasBackupsClientAdapter) Create(ctx context.Context, b *DBaaSBackup, opts ...CallOption) (_ *DBaaSBackup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "BackupsClient.Create", "DBaaSBackup", b)
+	defer func() { op.end(err) }()
+
 	if err := b.Err(); err != nil {
 		return b, err
 	}
@@ -296,11 +307,15 @@ func (a *dbaasBackupsClientAdapter) Create(ctx context.Context, b *DBaaSBackup,
 		return b, fmt.Errorf("Create: DBaaSBackup has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, b.ProjectID(), b.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, b, a.List, func(ctx context.Context) (*types.Response[types.BackupResponse], error) {
+		return a.low.Create(ctx, b.ProjectID(), b.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&b.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		b.fromResponse(resp.Data)
+		b.setRESTClient(a.rest)
 		b.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, b)
 			if err != nil {
@@ -316,18 +331,22 @@ func (a *dbaasBackupsClientAdapter) Create(ctx context.Context, b *DBaaSBackup,
 		return b, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return b, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return b, newHTTPError(resp, dbaasBackupFieldSetters)
 	}
 	return b, nil
 }
 
 // Get fetches a DBaaSBackup by Ref and returns a freshly hydrated wrapper.
-func (a *dbaasBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*DBaaSBackup, error) {
+func (a *dbaasBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *DBaaSBackup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "BackupsClient.Get", "DBaaSBackup", ref)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, err := dbaasBackupIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, backupID, rp)
 	out := &DBaaSBackup{}
@@ -335,6 +354,7 @@ func (a *dbaasBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...Ca
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -353,43 +373,51 @@ func (a *dbaasBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...Ca
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the DBaaSBackup identified by Ref.
-func (a *dbaasBackupsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *dbaasBackupsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "BackupsClient.Delete", "DBaaSBackup", ref)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, err := dbaasBackupIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, backupID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of DBaaSBackup entries in the given parent scope.
-func (a *dbaasBackupsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*DBaaSBackup], error) {
+func (a *dbaasBackupsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*DBaaSBackup], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "BackupsClient.List", "DBaaSBackup", parent)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*DBaaSBackup
 	if resp != nil && resp.Data != nil {
@@ -398,6 +426,7 @@ func (a *dbaasBackupsClientAdapter) List(ctx context.Context, parent Ref, opts .
 			b := &DBaaSBackup{}
 			b.projectID = projectID
 			b.fromResponse(&resp.Data.Values[i])
+			b.setRESTClient(a.rest)
 			b.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, b)
 				if err != nil {
@@ -422,7 +451,7 @@ func (a *dbaasBackupsClientAdapter) List(ctx context.Context, parent Ref, opts .
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*DBaaSBackup
 		if pageResp != nil && pageResp.Data != nil {
@@ -431,6 +460,7 @@ func (a *dbaasBackupsClientAdapter) List(ctx context.Context, parent Ref, opts .
 				b := &DBaaSBackup{}
 				b.projectID = projectID
 				b.fromResponse(&pageResp.Data.Values[i])
+				b.setRESTClient(a.rest)
 				b.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, b)
 					if err != nil {
@@ -135,6 +135,11 @@ func (e *ElasticIP) toRequest() types.ElasticIPRequest {
 	}
 }
 
+// elasticIPFieldSetters maps the wire paths of the ElasticIP request to its setters.
+var elasticIPFieldSetters = regionalFieldSetters("ElasticIP", map[string]string{
+	"properties.billingPlan": "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (e *ElasticIP) fromResponse(resp *types.ElasticIPResponse) {
 	if resp == nil {
@@ -226,7 +231,10 @@ func newElasticIPsClientAdapter(rest *restclient.Client) *elasticIPsClientAdapte
 }
 
 // Create posts a new ElasticIP to the API and hydrates the wrapper from the response.
-func (a *elasticIPsClientAdapter) Create(ctx context.Context, e *ElasticIP, opts ...CallOption) (*ElasticIP, error) {
+func (a *elasticIPsClientAdapter) Create(ctx context.Context, e *ElasticIP, opts ...CallOption) (_ *ElasticIP, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ElasticIPsClient.Create", "ElasticIP", e)
+	defer func() { op.end(err) }()
+
 	if err := e.Err(); err != nil {
 		return e, err
 	}
@@ -234,11 +242,15 @@ func (a *elasticIPsClientAdapter) Create(ctx context.Context, e *ElasticIP, opts
 		return e, fmt.Errorf("Create: elastic IP has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, e.ProjectID(), e.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, e, a.List, func(ctx context.Context) (*types.Response[types.ElasticIPResponse], error) {
+		return a.low.Create(ctx, e.ProjectID(), e.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&e.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		e.fromResponse(resp.Data)
+		e.setRESTClient(a.rest)
 		e.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, e)
 			if err != nil {
@@ -254,24 +266,29 @@ func (a *elasticIPsClientAdapter) Create(ctx context.Context, e *ElasticIP, opts
 		return e, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return e, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return e, newHTTPError(resp, elasticIPFieldSetters)
 	}
 	return e, nil
 }
 
 // Get fetches an ElasticIP by Ref and returns a freshly hydrated wrapper.
-func (a *elasticIPsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*ElasticIP, error) {
+func (a *elasticIPsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *ElasticIP, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ElasticIPsClient.Get", "ElasticIP", ref)
+	defer func() { op.end(err) }()
+
 	projectID, elasticIPID, err := elasticIPIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, elasticIPID, rp)
 	out := &ElasticIP{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -288,13 +305,16 @@ func (a *elasticIPsClientAdapter) Get(ctx context.Context, ref Ref, opts ...Call
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *elasticIPsClientAdapter) Update(ctx context.Context, e *ElasticIP, opts ...CallOption) (*ElasticIP, error) {
+func (a *elasticIPsClientAdapter) Update(ctx context.Context, e *ElasticIP, opts ...CallOption) (_ *ElasticIP, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ElasticIPsClient.Update", "ElasticIP", e)
+	defer func() { op.end(err) }()
+
 	if err := e.Err(); err != nil {
 		return e, err
 	}
@@ -305,11 +325,13 @@ func (a *elasticIPsClientAdapter) Update(ctx context.Context, e *ElasticIP, opts
 		return e, fmt.Errorf("Update: elastic IP has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(e.Version())
 	resp, err := a.low.Update(ctx, e.ProjectID(), e.ID(), e.toRequest(), rp)
 	populateHTTPEnvelope(&e.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		e.fromResponse(resp.Data)
+		e.setRESTClient(a.rest)
 		e.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, e)
 			if err != nil {
@@ -325,43 +347,51 @@ func (a *elasticIPsClientAdapter) Update(ctx context.Context, e *ElasticIP, opts
 		return e, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return e, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return e, newUpdateError(resp, rp, elasticIPFieldSetters)
 	}
 	return e, nil
 }
 
 // Delete removes the ElasticIP identified by Ref.
-func (a *elasticIPsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *elasticIPsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "ElasticIPsClient.Delete", "ElasticIP", ref)
+	defer func() { op.end(err) }()
+
 	projectID, elasticIPID, err := elasticIPIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, elasticIPID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of ElasticIP in the given parent scope.
-func (a *elasticIPsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*ElasticIP], error) {
+func (a *elasticIPsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*ElasticIP], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "ElasticIPsClient.List", "ElasticIP", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*ElasticIP
 	if resp != nil && resp.Data != nil {
@@ -369,6 +399,7 @@ func (a *elasticIPsClientAdapter) List(ctx context.Context, project Ref, opts ..
 		for i := range resp.Data.Values {
 			e := &ElasticIP{}
 			e.fromResponse(&resp.Data.Values[i])
+			e.setRESTClient(a.rest)
 			e.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, e)
 				if err != nil {
@@ -393,7 +424,7 @@ func (a *elasticIPsClientAdapter) List(ctx context.Context, project Ref, opts ..
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*ElasticIP
 		if pageResp != nil && pageResp.Data != nil {
@@ -401,6 +432,7 @@ func (a *elasticIPsClientAdapter) List(ctx context.Context, project Ref, opts ..
 			for i := range pageResp.Data.Values {
 				e := &ElasticIP{}
 				e.fromResponse(&pageResp.Data.Values[i])
+				e.setRESTClient(a.rest)
 				e.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, e)
 					if err != nil {
@@ -142,6 +142,12 @@ func (g *Grant) toRequest() types.GrantRequest {
 	}
 }
 
+// grantFieldSetters maps the wire paths of the Grant request to its setters.
+var grantFieldSetters = newFieldSetters("Grant", map[string]string{
+	"user": "ForUser",
+	"role": "OfRole",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (g *Grant) fromResponse(resp *types.GrantResponse) {
 	if resp == nil {
@@ -239,7 +245,10 @@ func newGrantsClientAdapter(rest *restclient.Client) *grantsClientAdapter {
 }
 
 // Create posts a new Grant to the API and hydrates the wrapper from the response.
-func (a *grantsClientAdapter) Create(ctx context.Context, g *Grant, opts ...CallOption) (*Grant, error) {
+func (a *grantsClientAdapter) Create(ctx context.Context, g *Grant, opts ...CallOption) (_ *Grant, err error) {
+	ctx, op := startOperation(ctx, a.rest, "GrantsClient.Create", "Grant", g)
+	defer func() { op.end(err) }()
+
 	if err := g.Err(); err != nil {
 		return g, err
 	}
@@ -259,11 +268,15 @@ func (a *grantsClientAdapter) Create(ctx context.Context, g *Grant, opts ...Call
 		return g, fmt.Errorf("Create: Grant has no role — call OfRole first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, g.ProjectID(), g.DBaaSID(), g.DatabaseID(), g.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, g, a.List, func(ctx context.Context) (*types.Response[types.GrantResponse], error) {
+		return a.low.Create(ctx, g.ProjectID(), g.DBaaSID(), g.DatabaseID(), g.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&g.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		g.fromResponse(resp.Data)
+		g.setRESTClient(a.rest)
 		g.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, g)
 			if err != nil {
@@ -279,13 +292,16 @@ func (a *grantsClientAdapter) Create(ctx context.Context, g *Grant, opts ...Call
 		return g, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return g, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return g, newHTTPError(resp, grantFieldSetters)
 	}
 	return g, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *grantsClientAdapter) Update(ctx context.Context, g *Grant, opts ...CallOption) (*Grant, error) {
+func (a *grantsClientAdapter) Update(ctx context.Context, g *Grant, opts ...CallOption) (_ *Grant, err error) {
+	ctx, op := startOperation(ctx, a.rest, "GrantsClient.Update", "Grant", g)
+	defer func() { op.end(err) }()
+
 	if err := g.Err(); err != nil {
 		return g, err
 	}
@@ -308,11 +324,13 @@ func (a *grantsClientAdapter) Update(ctx context.Context, g *Grant, opts ...Call
 		return g, fmt.Errorf("Update: Grant has no role — call OfRole first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(g.Version())
 	resp, err := a.low.Update(ctx, g.ProjectID(), g.DBaaSID(), g.DatabaseID(), g.ID(), g.toRequest(), rp)
 	populateHTTPEnvelope(&g.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		g.fromResponse(resp.Data)
+		g.setRESTClient(a.rest)
 		g.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, g)
 			if err != nil {
@@ -328,18 +346,22 @@ func (a *grantsClientAdapter) Update(ctx context.Context, g *Grant, opts ...Call
 		return g, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return g, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return g, newUpdateError(resp, rp, grantFieldSetters)
 	}
 	return g, nil
 }
 
 // Get fetches a Grant by Ref and returns a freshly hydrated wrapper.
-func (a *grantsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Grant, error) {
+func (a *grantsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Grant, err error) {
+	ctx, op := startOperation(ctx, a.rest, "GrantsClient.Get", "Grant", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, databaseID, grantID, err := grantIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, dbaasID, databaseID, grantID, rp)
 	out := &Grant{}
@@ -350,6 +372,7 @@ func (a *grantsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOpti
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -365,43 +388,51 @@ func (a *grantsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOpti
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the Grant identified by Ref.
-func (a *grantsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *grantsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "GrantsClient.Delete", "Grant", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, databaseID, grantID, err := grantIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, dbaasID, databaseID, grantID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Grants in the given Database scope.
-func (a *grantsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*Grant], error) {
+func (a *grantsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*Grant], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "GrantsClient.List", "Grant", parent)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, databaseID, err := databaseIDsFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, dbaasID, databaseID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Grant
 	if resp != nil && resp.Data != nil {
@@ -412,6 +443,7 @@ func (a *grantsClientAdapter) List(ctx context.Context, parent Ref, opts ...Call
 			g.dbaasID = dbaasID
 			g.projectID = projectID
 			g.fromResponse(&resp.Data.Values[i])
+			g.setRESTClient(a.rest)
 			g.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, g)
 				if err != nil {
@@ -433,7 +465,7 @@ func (a *grantsClientAdapter) List(ctx context.Context, parent Ref, opts ...Call
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Grant
 		if pageResp != nil && pageResp.Data != nil {
@@ -444,6 +476,7 @@ func (a *grantsClientAdapter) List(ctx context.Context, parent Ref, opts ...Call
 				g.dbaasID = dbaasID
 				g.projectID = projectID
 				g.fromResponse(&pageResp.Data.Values[i])
+				g.setRESTClient(a.rest)
 				g.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, g)
 					if err != nil {
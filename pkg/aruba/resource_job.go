@@ -303,6 +303,21 @@ func (j *Job) toRequest() types.JobRequest {
 	}
 }
 
+// jobFieldSetters maps the wire paths of the Job request to its setters.
+var jobFieldSetters = regionalFieldSetters("Job", map[string]string{
+	"properties.enabled":           "Enabled",
+	"properties.scheduleJobType":   "OfType",
+	"properties.scheduleAt":        "StartingAt",
+	"properties.executeUntil":      "RecurringUntil",
+	"properties.cron":              "WithCron",
+	"properties.steps":             "WithSteps",
+	"properties.steps.name":        "JobStep.Named",
+	"properties.steps.resourceUri": "JobStep.Targeting",
+	"properties.steps.actionUri":   "JobStep.WithAction",
+	"properties.steps.httpVerb":    "JobStep.WithVerb",
+	"properties.steps.body":        "JobStep.WithBody",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (j *Job) fromResponse(resp *types.JobResponse) {
 	if resp == nil {
@@ -446,7 +461,10 @@ func newJobsClientAdapter(rest *restclient.Client) *jobsClientAdapter {
 }
 
 // Create posts a new Job to the API and hydrates the wrapper from the response.
-func (a *jobsClientAdapter) Create(ctx context.Context, j *Job, opts ...CallOption) (*Job, error) {
+func (a *jobsClientAdapter) Create(ctx context.Context, j *Job, opts ...CallOption) (_ *Job, err error) {
+	ctx, op := startOperation(ctx, a.rest, "JobsClient.Create", "Job", j)
+	defer func() { op.end(err) }()
+
 	if err := j.Err(); err != nil {
 		return j, err
 	}
@@ -454,11 +472,15 @@ func (a *jobsClientAdapter) Create(ctx context.Context, j *Job, opts ...CallOpti
 		return j, fmt.Errorf("Create: Job has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, j.ProjectID(), j.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, j, a.List, func(ctx context.Context) (*types.Response[types.JobResponse], error) {
+		return a.low.Create(ctx, j.ProjectID(), j.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&j.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		j.fromResponse(resp.Data)
+		j.setRESTClient(a.rest)
 		j.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, j)
 			if err != nil {
@@ -474,13 +496,16 @@ func (a *jobsClientAdapter) Create(ctx context.Context, j *Job, opts ...CallOpti
 		return j, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return j, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return j, newHTTPError(resp, jobFieldSetters)
 	}
 	return j, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *jobsClientAdapter) Update(ctx context.Context, j *Job, opts ...CallOption) (*Job, error) {
+func (a *jobsClientAdapter) Update(ctx context.Context, j *Job, opts ...CallOption) (_ *Job, err error) {
+	ctx, op := startOperation(ctx, a.rest, "JobsClient.Update", "Job", j)
+	defer func() { op.end(err) }()
+
 	if err := j.Err(); err != nil {
 		return j, err
 	}
@@ -491,11 +516,13 @@ func (a *jobsClientAdapter) Update(ctx context.Context, j *Job, opts ...CallOpti
 		return j, fmt.Errorf("Update: Job has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(j.Version())
 	resp, err := a.low.Update(ctx, j.ProjectID(), j.JobID(), j.toRequest(), rp)
 	populateHTTPEnvelope(&j.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		j.fromResponse(resp.Data)
+		j.setRESTClient(a.rest)
 		j.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, j)
 			if err != nil {
@@ -511,18 +538,22 @@ func (a *jobsClientAdapter) Update(ctx context.Context, j *Job, opts ...CallOpti
 		return j, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return j, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return j, newUpdateError(resp, rp, jobFieldSetters)
 	}
 	return j, nil
 }
 
 // Get fetches a Job by Ref and returns a freshly hydrated wrapper.
-func (a *jobsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Job, error) {
+func (a *jobsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Job, err error) {
+	ctx, op := startOperation(ctx, a.rest, "JobsClient.Get", "Job", ref)
+	defer func() { op.end(err) }()
+
 	projectID, jobID, err := jobIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, jobID, rp)
 	out := &Job{}
@@ -530,6 +561,7 @@ func (a *jobsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -548,43 +580,51 @@ func (a *jobsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the Job identified by Ref.
-func (a *jobsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *jobsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "JobsClient.Delete", "Job", ref)
+	defer func() { op.end(err) }()
+
 	projectID, jobID, err := jobIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, jobID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Jobs in the given parent scope.
-func (a *jobsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*Job], error) {
+func (a *jobsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*Job], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "JobsClient.List", "Job", parent)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Job
 	if resp != nil && resp.Data != nil {
@@ -593,6 +633,7 @@ func (a *jobsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 			j := &Job{}
 			j.projectID = projectID
 			j.fromResponse(&resp.Data.Values[i])
+			j.setRESTClient(a.rest)
 			j.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, j)
 				if err != nil {
@@ -617,7 +658,7 @@ func (a *jobsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Job
 		if pageResp != nil && pageResp.Data != nil {
@@ -626,6 +667,7 @@ func (a *jobsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 				j := &Job{}
 				j.projectID = projectID
 				j.fromResponse(&pageResp.Data.Values[i])
+				j.setRESTClient(a.rest)
 				j.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, j)
 					if err != nil {
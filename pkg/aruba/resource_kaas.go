@@ -275,6 +275,7 @@ func (k *KaaS) DownloadKubeconfig(ctx context.Context, opts ...CallOption) ([]by
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := k.actions.downloadKubeconfig(ctx, k.ProjectID(), k.KaaSID(), rp)
 	populateHTTPEnvelope(&k.httpEnvelopeMixin, resp)
@@ -282,7 +283,7 @@ func (k *KaaS) DownloadKubeconfig(ctx context.Context, opts ...CallOption) ([]by
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	if resp == nil || resp.Data == nil {
 		return nil, nil
@@ -487,6 +488,31 @@ func (k *KaaS) toRequest() types.KaaSRequest {
 	}
 }
 
+// kaasFieldSetters maps the wire paths of the KaaS request to its setters.
+var kaasFieldSetters = regionalFieldSetters("KaaS", map[string]string{
+	"properties.vpc":                                         "WithVPC",
+	"properties.subnet":                                      "WithSubnet",
+	"properties.nodeCidr":                                    "WithNodeCIDR",
+	"properties.podCidr":                                     "WithPodCIDR",
+	"properties.securityGroup":                               "WithSecurityGroupName",
+	"properties.kubernetesVersion":                           "WithKubernetesVersion",
+	"properties.nodePools":                                   "WithNodePools",
+	"properties.nodePools.name":                              "NodePool.Named",
+	"properties.nodePools.instance":                          "NodePool.OfInstance",
+	"properties.nodePools.dataCenter":                        "NodePool.InZone",
+	"properties.nodePools.nodes":                             "NodePool.WithCount",
+	"properties.nodePools.autoscaling":                       "NodePool.WithAutoscaling",
+	"properties.nodePools.minCount":                          "NodePool.WithAutoscaling",
+	"properties.nodePools.maxCount":                          "NodePool.WithAutoscaling",
+	"properties.ha":                                          "HighlyAvailable",
+	"properties.storage":                                     "WithMaxStorageQuotaGB",
+	"properties.identity":                                    "WithIdentity",
+	"properties.billingPlan":                                 "BilledBy",
+	"properties.apiServerAccessProfile":                      "WithAPIServerAccessProfile",
+	"properties.apiServerAccessProfile.authorizedIpRanges":   "WithAuthorizedIPRanges",
+	"properties.apiServerAccessProfile.enablePrivateCluster": "WithPrivateCluster",
+})
+
 // toUpdateRequest emits KaaSUpdateRequest, which exposes only the mutable
 // fields (KubernetesVersion, NodePools, HA, Storage, BillingPlanCommon).
 // VPC, Subnet, SecurityGroup, and CIDRs are immutable after creation.
@@ -710,7 +736,10 @@ func newKaaSClientAdapter(rest *restclient.Client) *kaasClientAdapter {
 }
 
 // Create posts a new KaaS to the API and hydrates the wrapper from the response.
-func (a *kaasClientAdapter) Create(ctx context.Context, k *KaaS, opts ...CallOption) (*KaaS, error) {
+func (a *kaasClientAdapter) Create(ctx context.Context, k *KaaS, opts ...CallOption) (_ *KaaS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KaaSClient.Create", "KaaS", k)
+	defer func() { op.end(err) }()
+
 	if err := k.Err(); err != nil {
 		return k, err
 	}
@@ -718,11 +747,15 @@ func (a *kaasClientAdapter) Create(ctx context.Context, k *KaaS, opts ...CallOpt
 		return k, fmt.Errorf("Create: KaaS has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, k.ProjectID(), k.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, k, a.List, func(ctx context.Context) (*types.Response[types.KaaSResponse], error) {
+		return a.low.Create(ctx, k.ProjectID(), k.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&k.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		k.fromResponse(resp.Data)
+		k.setRESTClient(a.rest)
 		k.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, k)
 			if err != nil {
@@ -739,13 +772,16 @@ func (a *kaasClientAdapter) Create(ctx context.Context, k *KaaS, opts ...CallOpt
 		return k, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return k, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return k, newHTTPError(resp, kaasFieldSetters)
 	}
 	return k, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *kaasClientAdapter) Update(ctx context.Context, k *KaaS, opts ...CallOption) (*KaaS, error) {
+func (a *kaasClientAdapter) Update(ctx context.Context, k *KaaS, opts ...CallOption) (_ *KaaS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KaaSClient.Update", "KaaS", k)
+	defer func() { op.end(err) }()
+
 	if err := k.Err(); err != nil {
 		return k, err
 	}
@@ -756,11 +792,13 @@ func (a *kaasClientAdapter) Update(ctx context.Context, k *KaaS, opts ...CallOpt
 		return k, fmt.Errorf("Update: KaaS has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(k.Version())
 	resp, err := a.low.Update(ctx, k.ProjectID(), k.KaaSID(), k.toUpdateRequest(), rp)
 	populateHTTPEnvelope(&k.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		k.fromResponse(resp.Data)
+		k.setRESTClient(a.rest)
 		k.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, k)
 			if err != nil {
@@ -777,18 +815,22 @@ func (a *kaasClientAdapter) Update(ctx context.Context, k *KaaS, opts ...CallOpt
 		return k, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return k, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return k, newUpdateError(resp, rp, kaasFieldSetters)
 	}
 	return k, nil
 }
 
 // Get fetches a KaaS by Ref and returns a freshly hydrated wrapper.
-func (a *kaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*KaaS, error) {
+func (a *kaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *KaaS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KaaSClient.Get", "KaaS", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kaasID, err := kaasIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, kaasID, rp)
 	out := &KaaS{}
@@ -796,6 +838,7 @@ func (a *kaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -815,43 +858,51 @@ func (a *kaasClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the KaaS identified by Ref.
-func (a *kaasClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *kaasClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "KaaSClient.Delete", "KaaS", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kaasID, err := kaasIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, kaasID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of KaaS in the given parent scope.
-func (a *kaasClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*KaaS], error) {
+func (a *kaasClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*KaaS], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "KaaSClient.List", "KaaS", parent)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*KaaS
 	if resp != nil && resp.Data != nil {
@@ -860,6 +911,7 @@ func (a *kaasClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 			k := &KaaS{}
 			k.projectID = projectID
 			k.fromResponse(&resp.Data.Values[i])
+			k.setRESTClient(a.rest)
 			k.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, k)
 				if err != nil {
@@ -885,7 +937,7 @@ func (a *kaasClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*KaaS
 		if pageResp != nil && pageResp.Data != nil {
@@ -894,6 +946,7 @@ func (a *kaasClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 				k := &KaaS{}
 				k.projectID = projectID
 				k.fromResponse(&pageResp.Data.Values[i])
+				k.setRESTClient(a.rest)
 				k.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, k)
 					if err != nil {
@@ -917,6 +970,9 @@ func (a *kaasClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 }
 
 // downloadKubeconfig satisfies kaasActions (lowercase, internal interface).
-func (a *kaasClientAdapter) downloadKubeconfig(ctx context.Context, projectID, kaasID string, rp *types.RequestParameters) (*types.Response[types.KaaSKubeconfigResponse], error) {
+func (a *kaasClientAdapter) downloadKubeconfig(ctx context.Context, projectID, kaasID string, rp *types.RequestParameters) (resp *types.Response[types.KaaSKubeconfigResponse], err error) {
+	ctx, op := startOperation(ctx, a.rest, "KaaS.DownloadKubeconfig", "KaaS", idRef{projectID: projectID, id: kaasID})
+	defer func() { endAction(op, resp, err) }()
+
 	return a.low.DownloadKubeconfig(ctx, projectID, kaasID, rp)
 }
@@ -148,6 +148,12 @@ func (k *Key) toRequest() types.KeyRequest {
 	return req
 }
 
+// keyFieldSetters maps the wire paths of the Key request to its setters.
+var keyFieldSetters = newFieldSetters("Key", map[string]string{
+	"name":      "Named",
+	"algorithm": "OfAlgorithm",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (k *Key) fromResponse(resp *types.KeyResponse) {
 	if resp == nil {
@@ -235,7 +241,10 @@ func newKeysClientAdapter(rest *restclient.Client) *keysClientAdapter {
 }
 
 // Create posts a new Key to the API and hydrates the wrapper from the response.
-func (a *keysClientAdapter) Create(ctx context.Context, k *Key, opts ...CallOption) (*Key, error) {
+func (a *keysClientAdapter) Create(ctx context.Context, k *Key, opts ...CallOption) (_ *Key, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KeysClient.Create", "Key", k)
+	defer func() { op.end(err) }()
+
 	if err := k.Err(); err != nil {
 		return k, err
 	}
@@ -246,11 +255,15 @@ func (a *keysClientAdapter) Create(ctx context.Context, k *Key, opts ...CallOpti
 		return k, fmt.Errorf("Create: Key has no parent KMS — call InKMS first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, k.ProjectID(), k.KMSID(), k.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, k, a.List, func(ctx context.Context) (*types.Response[types.KeyResponse], error) {
+		return a.low.Create(ctx, k.ProjectID(), k.KMSID(), k.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&k.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		k.fromResponse(resp.Data)
+		k.setRESTClient(a.rest)
 		k.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, k)
 			if err != nil {
@@ -266,18 +279,22 @@ func (a *keysClientAdapter) Create(ctx context.Context, k *Key, opts ...CallOpti
 		return k, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return k, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return k, newHTTPError(resp, keyFieldSetters)
 	}
 	return k, nil
 }
 
 // Get fetches a Key by Ref and returns a freshly hydrated wrapper.
-func (a *keysClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Key, error) {
+func (a *keysClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Key, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KeysClient.Get", "Key", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, keyID, err := keyIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, kmsID, keyID, rp)
 	out := &Key{}
@@ -286,6 +303,7 @@ func (a *keysClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -301,43 +319,51 @@ func (a *keysClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the Key identified by Ref.
-func (a *keysClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *keysClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "KeysClient.Delete", "Key", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, keyID, err := keyIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, kmsID, keyID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Key in the given KMS parent scope.
-func (a *keysClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*Key], error) {
+func (a *keysClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*Key], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "KeysClient.List", "Key", parent)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, err := kmsIDsFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, kmsID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Key
 	if resp != nil && resp.Data != nil {
@@ -347,6 +373,7 @@ func (a *keysClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 			k.projectID = projectID
 			k.kmsID = kmsID
 			k.fromResponse(&resp.Data.Values[i])
+			k.setRESTClient(a.rest)
 			k.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, k)
 				if err != nil {
@@ -368,7 +395,7 @@ func (a *keysClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Key
 		if pageResp != nil && pageResp.Data != nil {
@@ -378,6 +405,7 @@ func (a *keysClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOp
 				k.projectID = projectID
 				k.kmsID = kmsID
 				k.fromResponse(&pageResp.Data.Values[i])
+				k.setRESTClient(a.rest)
 				k.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, k)
 					if err != nil {
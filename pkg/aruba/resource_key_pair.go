@@ -110,6 +110,11 @@ func (k *KeyPair) toRequest() types.KeyPairRequest {
 	}
 }
 
+// keyPairFieldSetters maps the wire paths of the KeyPair request to its setters.
+var keyPairFieldSetters = regionalFieldSetters("KeyPair", map[string]string{
+	"properties.value": "WithPublicKey",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (k *KeyPair) fromResponse(resp *types.KeyPairResponse) {
 	if resp == nil {
@@ -181,7 +186,10 @@ func newKeyPairsClientAdapter(rest *restclient.Client) *keyPairsClientAdapter {
 }
 
 // Create posts a new KeyPair to the API and hydrates the wrapper from the response.
-func (a *keyPairsClientAdapter) Create(ctx context.Context, kp *KeyPair, opts ...CallOption) (*KeyPair, error) {
+func (a *keyPairsClientAdapter) Create(ctx context.Context, kp *KeyPair, opts ...CallOption) (_ *KeyPair, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KeyPairsClient.Create", "KeyPair", kp)
+	defer func() { op.end(err) }()
+
 	if err := kp.Err(); err != nil {
 		return kp, err
 	}
@@ -189,11 +197,15 @@ func (a *keyPairsClientAdapter) Create(ctx context.Context, kp *KeyPair, opts ..
 		return kp, fmt.Errorf("Create: KeyPair has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, kp.ProjectID(), kp.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, kp, a.List, func(ctx context.Context) (*types.Response[types.KeyPairResponse], error) {
+		return a.low.Create(ctx, kp.ProjectID(), kp.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&kp.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		kp.fromResponse(resp.Data)
+		kp.setRESTClient(a.rest)
 		kp.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, kp)
 			if err != nil {
@@ -209,24 +221,29 @@ func (a *keyPairsClientAdapter) Create(ctx context.Context, kp *KeyPair, opts ..
 		return kp, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return kp, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return kp, newHTTPError(resp, keyPairFieldSetters)
 	}
 	return kp, nil
 }
 
 // Get fetches a KeyPair by Ref and returns a freshly hydrated wrapper.
-func (a *keyPairsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*KeyPair, error) {
+func (a *keyPairsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *KeyPair, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KeyPairsClient.Get", "KeyPair", ref)
+	defer func() { op.end(err) }()
+
 	projectID, keyPairID, err := keyPairIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, keyPairID, rp)
 	out := &KeyPair{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -245,43 +262,51 @@ func (a *keyPairsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOp
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the KeyPair identified by Ref.
-func (a *keyPairsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *keyPairsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "KeyPairsClient.Delete", "KeyPair", ref)
+	defer func() { op.end(err) }()
+
 	projectID, keyPairID, err := keyPairIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, keyPairID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of KeyPair in the given parent scope.
-func (a *keyPairsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*KeyPair], error) {
+func (a *keyPairsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*KeyPair], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "KeyPairsClient.List", "KeyPair", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*KeyPair
 	if resp != nil && resp.Data != nil {
@@ -303,7 +328,7 @@ func (a *keyPairsClientAdapter) List(ctx context.Context, project Ref, opts ...C
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*KeyPair
 		if pageResp != nil && pageResp.Data != nil {
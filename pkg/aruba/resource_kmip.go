@@ -192,6 +192,11 @@ func (km *Kmip) toRequest() types.KmipRequest {
 	return req
 }
 
+// kmipFieldSetters maps the wire paths of the Kmip request to its setters.
+var kmipFieldSetters = newFieldSetters("Kmip", map[string]string{
+	"name": "Named",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (km *Kmip) fromResponse(resp *types.KmipResponse) {
 	if resp == nil {
@@ -274,7 +279,10 @@ func newKmipsClientAdapter(rest *restclient.Client) *kmipsClientAdapter {
 }
 
 // Create posts a new Kmip to the API and hydrates the wrapper from the response.
-func (a *kmipsClientAdapter) Create(ctx context.Context, km *Kmip, opts ...CallOption) (*Kmip, error) {
+func (a *kmipsClientAdapter) Create(ctx context.Context, km *Kmip, opts ...CallOption) (_ *Kmip, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KmipsClient.Create", "Kmip", km)
+	defer func() { op.end(err) }()
+
 	if err := km.Err(); err != nil {
 		return km, err
 	}
@@ -285,11 +293,15 @@ func (a *kmipsClientAdapter) Create(ctx context.Context, km *Kmip, opts ...CallO
 		return km, fmt.Errorf("Create: Kmip has no parent KMS — call InKMS first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, km.ProjectID(), km.KMSID(), km.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, km, a.List, func(ctx context.Context) (*types.Response[types.KmipResponse], error) {
+		return a.low.Create(ctx, km.ProjectID(), km.KMSID(), km.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&km.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		km.fromResponse(resp.Data)
+		km.setRESTClient(a.rest)
 		km.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, km)
 			if err != nil {
@@ -305,18 +317,22 @@ func (a *kmipsClientAdapter) Create(ctx context.Context, km *Kmip, opts ...CallO
 		return km, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return km, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return km, newHTTPError(resp, kmipFieldSetters)
 	}
 	return km, nil
 }
 
 // Get fetches a Kmip by Ref and returns a freshly hydrated wrapper.
-func (a *kmipsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Kmip, error) {
+func (a *kmipsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Kmip, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KmipsClient.Get", "Kmip", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, kmipID, err := kmipIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, kmsID, kmipID, rp)
 	out := &Kmip{}
@@ -325,6 +341,7 @@ func (a *kmipsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOptio
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -340,43 +357,51 @@ func (a *kmipsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOptio
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the Kmip identified by Ref.
-func (a *kmipsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *kmipsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "KmipsClient.Delete", "Kmip", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, kmipID, err := kmipIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, kmsID, kmipID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Kmip in the given parent scope.
-func (a *kmipsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*Kmip], error) {
+func (a *kmipsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*Kmip], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "KmipsClient.List", "Kmip", parent)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, err := kmsIDsFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, kmsID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Kmip
 	if resp != nil && resp.Data != nil {
@@ -386,6 +411,7 @@ func (a *kmipsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallO
 			km.projectID = projectID
 			km.kmsID = kmsID
 			km.fromResponse(&resp.Data.Values[i])
+			km.setRESTClient(a.rest)
 			km.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, km)
 				if err != nil {
@@ -407,7 +433,7 @@ func (a *kmipsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallO
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Kmip
 		if pageResp != nil && pageResp.Data != nil {
@@ -417,6 +443,7 @@ func (a *kmipsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallO
 				km.projectID = projectID
 				km.kmsID = kmsID
 				km.fromResponse(&pageResp.Data.Values[i])
+				km.setRESTClient(a.rest)
 				km.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, km)
 					if err != nil {
@@ -436,19 +463,23 @@ func (a *kmipsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallO
 }
 
 // Download retrieves the KMIP certificate key+cert pair for the given Ref.
-func (a *kmipsClientAdapter) Download(ctx context.Context, ref Ref, opts ...CallOption) (*KmipCertificate, error) {
+func (a *kmipsClientAdapter) Download(ctx context.Context, ref Ref, opts ...CallOption) (_ *KmipCertificate, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KmipsClient.Download", "Kmip", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, kmipID, err := kmipIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Download(ctx, projectID, kmsID, kmipID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	if resp != nil {
 		return &KmipCertificate{response: resp.Data}, nil
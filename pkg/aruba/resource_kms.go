@@ -120,6 +120,11 @@ func (k *KMS) toRequest() types.KmsRequest {
 	}
 }
 
+// kmsFieldSetters maps the wire paths of the KMS request to its setters.
+var kmsFieldSetters = regionalFieldSetters("KMS", map[string]string{
+	"properties.billingPeriod": "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (k *KMS) fromResponse(resp *types.KmsResponse) {
 	if resp == nil {
@@ -214,7 +219,10 @@ func newKMSClientAdapter(rest *restclient.Client) *kmsClientAdapter {
 }
 
 // Create posts a new KMS to the API and hydrates the wrapper from the response.
-func (a *kmsClientAdapter) Create(ctx context.Context, k *KMS, opts ...CallOption) (*KMS, error) {
+func (a *kmsClientAdapter) Create(ctx context.Context, k *KMS, opts ...CallOption) (_ *KMS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KMSClient.Create", "KMS", k)
+	defer func() { op.end(err) }()
+
 	if err := k.Err(); err != nil {
 		return k, err
 	}
@@ -222,11 +230,15 @@ func (a *kmsClientAdapter) Create(ctx context.Context, k *KMS, opts ...CallOptio
 		return k, fmt.Errorf("Create: KMS has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, k.ProjectID(), k.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, k, a.List, func(ctx context.Context) (*types.Response[types.KmsResponse], error) {
+		return a.low.Create(ctx, k.ProjectID(), k.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&k.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		k.fromResponse(resp.Data)
+		k.setRESTClient(a.rest)
 		k.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, k)
 			if err != nil {
@@ -242,13 +254,16 @@ func (a *kmsClientAdapter) Create(ctx context.Context, k *KMS, opts ...CallOptio
 		return k, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return k, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return k, newHTTPError(resp, kmsFieldSetters)
 	}
 	return k, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *kmsClientAdapter) Update(ctx context.Context, k *KMS, opts ...CallOption) (*KMS, error) {
+func (a *kmsClientAdapter) Update(ctx context.Context, k *KMS, opts ...CallOption) (_ *KMS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KMSClient.Update", "KMS", k)
+	defer func() { op.end(err) }()
+
 	if err := k.Err(); err != nil {
 		return k, err
 	}
@@ -259,11 +274,13 @@ func (a *kmsClientAdapter) Update(ctx context.Context, k *KMS, opts ...CallOptio
 		return k, fmt.Errorf("Update: KMS has no parent project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(k.Version())
 	resp, err := a.low.Update(ctx, k.ProjectID(), k.KMSID(), k.toRequest(), rp)
 	populateHTTPEnvelope(&k.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		k.fromResponse(resp.Data)
+		k.setRESTClient(a.rest)
 		k.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, k)
 			if err != nil {
@@ -279,18 +296,22 @@ func (a *kmsClientAdapter) Update(ctx context.Context, k *KMS, opts ...CallOptio
 		return k, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return k, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return k, newUpdateError(resp, rp, kmsFieldSetters)
 	}
 	return k, nil
 }
 
 // Get fetches a KMS by Ref and returns a freshly hydrated wrapper.
-func (a *kmsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*KMS, error) {
+func (a *kmsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *KMS, err error) {
+	ctx, op := startOperation(ctx, a.rest, "KMSClient.Get", "KMS", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, err := kmsIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, kmsID, rp)
 	out := &KMS{}
@@ -298,6 +319,7 @@ func (a *kmsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption)
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -316,43 +338,51 @@ func (a *kmsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption)
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the KMS identified by Ref.
-func (a *kmsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *kmsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "KMSClient.Delete", "KMS", ref)
+	defer func() { op.end(err) }()
+
 	projectID, kmsID, err := kmsIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, kmsID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of KMS in the given parent scope.
-func (a *kmsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (*List[*KMS], error) {
+func (a *kmsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOption) (_ *List[*KMS], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "KMSClient.List", "KMS", parent)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(parent)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*KMS
 	if resp != nil && resp.Data != nil {
@@ -361,6 +391,7 @@ func (a *kmsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOpt
 			k := &KMS{}
 			k.projectID = projectID
 			k.fromResponse(&resp.Data.Values[i])
+			k.setRESTClient(a.rest)
 			k.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, k)
 				if err != nil {
@@ -385,7 +416,7 @@ func (a *kmsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOpt
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*KMS
 		if pageResp != nil && pageResp.Data != nil {
@@ -394,6 +425,7 @@ func (a *kmsClientAdapter) List(ctx context.Context, parent Ref, opts ...CallOpt
 				k := &KMS{}
 				k.projectID = projectID
 				k.fromResponse(&pageResp.Data.Values[i])
+				k.setRESTClient(a.rest)
 				k.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, k)
 					if err != nil {
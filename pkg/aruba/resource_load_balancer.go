@@ -137,18 +137,23 @@ func newLoadBalancersClientAdapter(rest *restclient.Client) *loadBalancersClient
 }
 
 // Get fetches a LoadBalancer by Ref and returns a freshly hydrated wrapper.
-func (a *loadBalancersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*LoadBalancer, error) {
+func (a *loadBalancersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *LoadBalancer, err error) {
+	ctx, op := startOperation(ctx, a.rest, "LoadBalancersClient.Get", "LoadBalancer", ref)
+	defer func() { op.end(err) }()
+
 	projectID, loadBalancerID, err := loadBalancerIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, loadBalancerID, rp)
 	out := &LoadBalancer{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -165,25 +170,29 @@ func (a *loadBalancersClientAdapter) Get(ctx context.Context, ref Ref, opts ...C
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // List returns a paginated list of LoadBalancer in the given parent scope.
-func (a *loadBalancersClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*LoadBalancer], error) {
+func (a *loadBalancersClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*LoadBalancer], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "LoadBalancersClient.List", "LoadBalancer", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*LoadBalancer
 	if resp != nil && resp.Data != nil {
@@ -191,6 +200,7 @@ func (a *loadBalancersClientAdapter) List(ctx context.Context, project Ref, opts
 		for i := range resp.Data.Values {
 			lb := &LoadBalancer{}
 			lb.fromResponse(&resp.Data.Values[i])
+			lb.setRESTClient(a.rest)
 			lb.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, lb)
 				if err != nil {
@@ -215,7 +225,7 @@ func (a *loadBalancersClientAdapter) List(ctx context.Context, project Ref, opts
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*LoadBalancer
 		if pageResp != nil && pageResp.Data != nil {
@@ -223,6 +233,7 @@ func (a *loadBalancersClientAdapter) List(ctx context.Context, project Ref, opts
 			for i := range pageResp.Data.Values {
 				lb := &LoadBalancer{}
 				lb.fromResponse(&pageResp.Data.Values[i])
+				lb.setRESTClient(a.rest)
 				lb.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, lb)
 					if err != nil {
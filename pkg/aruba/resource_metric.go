@@ -118,19 +118,23 @@ func newMetricsClientAdapter(rest *restclient.Client) *metricsClientAdapter {
 }
 
 // List returns a paginated list of Metric in the given parent scope.
-func (a *metricsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*Metric], error) {
+func (a *metricsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*Metric], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "MetricsClient.List", "Metric", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Metric
 	if resp != nil && resp.Data != nil {
@@ -151,7 +155,7 @@ func (a *metricsClientAdapter) List(ctx context.Context, project Ref, opts ...Ca
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Metric
 		if pageResp != nil && pageResp.Data != nil {
@@ -104,6 +104,14 @@ func (p *Project) toRequest() types.ProjectRequest {
 	}
 }
 
+// projectFieldSetters maps the wire paths of the Project request to its setters.
+var projectFieldSetters = newFieldSetters("Project", map[string]string{
+	"metadata.name":          "Named",
+	"metadata.tags":          "Tagged",
+	"properties.description": "DescribedAs",
+	"properties.default":     "AsDefault",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (p *Project) fromResponse(resp *types.ProjectResponse) {
 	if resp == nil {
@@ -162,13 +170,19 @@ func newProjectClientAdapter(rest *restclient.Client) *projectClientAdapter {
 }
 
 // Create posts a new Project to the API and hydrates the wrapper from the response.
-func (a *projectClientAdapter) Create(ctx context.Context, p *Project, opts ...CallOption) (*Project, error) {
+func (a *projectClientAdapter) Create(ctx context.Context, p *Project, opts ...CallOption) (_ *Project, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ProjectClient.Create", "Project", p)
+	defer func() { op.end(err) }()
+
 	if err := p.Err(); err != nil {
 		return p, err
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, p.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, p, a.listIn, func(ctx context.Context) (*types.Response[types.ProjectResponse], error) {
+		return a.low.Create(ctx, p.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&p.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		p.fromResponse(resp.Data)
@@ -180,18 +194,22 @@ func (a *projectClientAdapter) Create(ctx context.Context, p *Project, opts ...C
 		return p, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return p, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return p, newHTTPError(resp, projectFieldSetters)
 	}
 	return p, nil
 }
 
 // Get fetches a Project by Ref and returns a freshly hydrated wrapper.
-func (a *projectClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Project, error) {
+func (a *projectClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Project, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ProjectClient.Get", "Project", ref)
+	defer func() { op.end(err) }()
+
 	id, err := projectIDFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, id, rp)
 	out := &Project{}
@@ -203,13 +221,16 @@ func (a *projectClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOpt
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *projectClientAdapter) Update(ctx context.Context, p *Project, opts ...CallOption) (*Project, error) {
+func (a *projectClientAdapter) Update(ctx context.Context, p *Project, opts ...CallOption) (_ *Project, err error) {
+	ctx, op := startOperation(ctx, a.rest, "ProjectClient.Update", "Project", p)
+	defer func() { op.end(err) }()
+
 	if err := p.Err(); err != nil {
 		return p, err
 	}
@@ -217,7 +238,8 @@ func (a *projectClientAdapter) Update(ctx context.Context, p *Project, opts ...C
 		return p, fmt.Errorf("Update: project has no ID — call Get first or seed from Raw metadata")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(p.Version())
 	resp, err := a.low.Update(ctx, p.ID(), p.toRequest(), rp)
 	populateHTTPEnvelope(&p.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
@@ -227,39 +249,47 @@ func (a *projectClientAdapter) Update(ctx context.Context, p *Project, opts ...C
 		return p, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return p, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return p, newUpdateError(resp, rp, projectFieldSetters)
 	}
 	return p, nil
 }
 
 // Delete removes the Project identified by Ref.
-func (a *projectClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *projectClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "ProjectClient.Delete", "Project", ref)
+	defer func() { op.end(err) }()
+
 	id, err := projectIDFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, id, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of all Projects accessible to the caller.
-func (a *projectClientAdapter) List(ctx context.Context, opts ...CallOption) (*List[*Project], error) {
+func (a *projectClientAdapter) List(ctx context.Context, opts ...CallOption) (_ *List[*Project], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "ProjectClient.List", "Project", nil)
+	defer func() { op.end(err) }()
+
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Project
 	if resp != nil && resp.Data != nil {
@@ -278,7 +308,7 @@ func (a *projectClientAdapter) List(ctx context.Context, opts ...CallOption) (*L
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Project
 		if pageResp != nil && pageResp.Data != nil {
@@ -293,3 +323,9 @@ func (a *projectClientAdapter) List(ctx context.Context, opts ...CallOption) (*L
 	}
 	return newListFromResponse(items, resp, opts, refetch), nil
 }
+
+// listIn adapts List to the signature expected by createWithRecovery:
+// projects have no parent.
+func (a *projectClientAdapter) listIn(ctx context.Context, _ Ref, opts ...CallOption) (*List[*Project], error) {
+	return a.List(ctx, opts...)
+}
@@ -121,6 +121,13 @@ func (sg *SecurityGroup) toRequest() types.SecurityGroupRequest {
 	}
 }
 
+// securityGroupFieldSetters maps the wire paths of the SecurityGroup request to its setters.
+var securityGroupFieldSetters = newFieldSetters("SecurityGroup", map[string]string{
+	"metadata.name":      "Named",
+	"metadata.tags":      "Tagged",
+	"properties.default": "AsDefault",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (sg *SecurityGroup) fromResponse(resp *types.SecurityGroupResponse) {
 	if resp == nil {
@@ -199,7 +206,10 @@ func newSecurityGroupsClientAdapter(rest *restclient.Client) *securityGroupsClie
 }
 
 // Create posts a new SecurityGroup to the API and hydrates the wrapper from the response.
-func (a *securityGroupsClientAdapter) Create(ctx context.Context, sg *SecurityGroup, opts ...CallOption) (*SecurityGroup, error) {
+func (a *securityGroupsClientAdapter) Create(ctx context.Context, sg *SecurityGroup, opts ...CallOption) (_ *SecurityGroup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupsClient.Create", "SecurityGroup", sg)
+	defer func() { op.end(err) }()
+
 	if err := sg.Err(); err != nil {
 		return sg, err
 	}
@@ -207,11 +217,15 @@ func (a *securityGroupsClientAdapter) Create(ctx context.Context, sg *SecurityGr
 		return sg, fmt.Errorf("Create: security group has no VPC — call InVPC first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, sg.ProjectID(), sg.VPCID(), sg.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, sg, a.List, func(ctx context.Context) (*types.Response[types.SecurityGroupResponse], error) {
+		return a.low.Create(ctx, sg.ProjectID(), sg.VPCID(), sg.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&sg.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		sg.fromResponse(resp.Data)
+		sg.setRESTClient(a.rest)
 		sg.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, sg)
 			if err != nil {
@@ -227,24 +241,29 @@ func (a *securityGroupsClientAdapter) Create(ctx context.Context, sg *SecurityGr
 		return sg, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return sg, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return sg, newHTTPError(resp, securityGroupFieldSetters)
 	}
 	return sg, nil
 }
 
 // Get fetches a SecurityGroup by Ref and returns a freshly hydrated wrapper.
-func (a *securityGroupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*SecurityGroup, error) {
+func (a *securityGroupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *SecurityGroup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupsClient.Get", "SecurityGroup", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, securityGroupID, err := securityGroupIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpcID, securityGroupID, rp)
 	out := &SecurityGroup{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -262,13 +281,16 @@ func (a *securityGroupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *securityGroupsClientAdapter) Update(ctx context.Context, sg *SecurityGroup, opts ...CallOption) (*SecurityGroup, error) {
+func (a *securityGroupsClientAdapter) Update(ctx context.Context, sg *SecurityGroup, opts ...CallOption) (_ *SecurityGroup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupsClient.Update", "SecurityGroup", sg)
+	defer func() { op.end(err) }()
+
 	if err := sg.Err(); err != nil {
 		return sg, err
 	}
@@ -279,11 +301,13 @@ func (a *securityGroupsClientAdapter) Update(ctx context.Context, sg *SecurityGr
 		return sg, fmt.Errorf("Update: security group has no VPC — call InVPC first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(sg.Version())
 	resp, err := a.low.Update(ctx, sg.ProjectID(), sg.VPCID(), sg.ID(), sg.toRequest(), rp)
 	populateHTTPEnvelope(&sg.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		sg.fromResponse(resp.Data)
+		sg.setRESTClient(a.rest)
 		sg.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, sg)
 			if err != nil {
@@ -299,43 +323,51 @@ func (a *securityGroupsClientAdapter) Update(ctx context.Context, sg *SecurityGr
 		return sg, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return sg, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return sg, newUpdateError(resp, rp, securityGroupFieldSetters)
 	}
 	return sg, nil
 }
 
 // Delete removes the SecurityGroup identified by Ref.
-func (a *securityGroupsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *securityGroupsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupsClient.Delete", "SecurityGroup", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, securityGroupID, err := securityGroupIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpcID, securityGroupID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of SecurityGroup in the given parent scope.
-func (a *securityGroupsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOption) (*List[*SecurityGroup], error) {
+func (a *securityGroupsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOption) (_ *List[*SecurityGroup], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "SecurityGroupsClient.List", "SecurityGroup", vpc)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, err := vpcIDsFromRef(vpc)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, vpcID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*SecurityGroup
 	if resp != nil && resp.Data != nil {
@@ -343,6 +375,7 @@ func (a *securityGroupsClientAdapter) List(ctx context.Context, vpc Ref, opts ..
 		for i := range resp.Data.Values {
 			sg := &SecurityGroup{}
 			sg.fromResponse(&resp.Data.Values[i])
+			sg.setRESTClient(a.rest)
 			sg.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, sg)
 				if err != nil {
@@ -370,7 +403,7 @@ func (a *securityGroupsClientAdapter) List(ctx context.Context, vpc Ref, opts ..
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*SecurityGroup
 		if pageResp != nil && pageResp.Data != nil {
@@ -378,6 +411,7 @@ func (a *securityGroupsClientAdapter) List(ctx context.Context, vpc Ref, opts ..
 			for i := range pageResp.Data.Values {
 				item := &SecurityGroup{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
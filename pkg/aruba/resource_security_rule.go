@@ -204,6 +204,32 @@ func (r *SecurityRule) toRequest() types.SecurityRuleRequest {
 	}
 }
 
+// securityRuleFieldSetters maps the wire paths of the SecurityRule request to
+// its setters, for rules targeting a CIDR.
+var securityRuleFieldSetters = regionalFieldSetters("SecurityRule", map[string]string{
+	"properties.direction": "WithDirection",
+	"properties.protocol":  "WithProtocol",
+	"properties.port":      "WithPort",
+	"properties.target":    "TargetingCIDR",
+})
+
+// securityRuleGroupFieldSetters is securityRuleFieldSetters for rules
+// targeting a security group.
+var securityRuleGroupFieldSetters = regionalFieldSetters("SecurityRule", map[string]string{
+	"properties.direction": "WithDirection",
+	"properties.protocol":  "WithProtocol",
+	"properties.port":      "WithPort",
+	"properties.target":    "TargetingSecurityGroup",
+})
+
+// fieldSetters returns the field setters matching the target kind of the rule.
+func (r *SecurityRule) fieldSetters() *fieldSetters {
+	if r.target != nil && r.target.Kind == types.EndpointTypeSecurityGroup {
+		return securityRuleGroupFieldSetters
+	}
+	return securityRuleFieldSetters
+}
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (r *SecurityRule) fromResponse(resp *types.SecurityRuleResponse) {
 	if resp == nil {
@@ -303,7 +329,10 @@ func newSecurityRulesClientAdapter(rest *restclient.Client) *securityRulesClient
 }
 
 // Create posts a new SecurityRule to the API and hydrates the wrapper from the response.
-func (a *securityRulesClientAdapter) Create(ctx context.Context, rule *SecurityRule, opts ...CallOption) (*SecurityRule, error) {
+func (a *securityRulesClientAdapter) Create(ctx context.Context, rule *SecurityRule, opts ...CallOption) (_ *SecurityRule, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupRulesClient.Create", "SecurityRule", rule)
+	defer func() { op.end(err) }()
+
 	if err := rule.Err(); err != nil {
 		return rule, err
 	}
@@ -311,11 +340,15 @@ func (a *securityRulesClientAdapter) Create(ctx context.Context, rule *SecurityR
 		return rule, fmt.Errorf("Create: security rule has no SecurityGroup — call InSecurityGroup first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, rule.ProjectID(), rule.VPCID(), rule.SecurityGroupID(), rule.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, rule, a.List, func(ctx context.Context) (*types.Response[types.SecurityRuleResponse], error) {
+		return a.low.Create(ctx, rule.ProjectID(), rule.VPCID(), rule.SecurityGroupID(), rule.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&rule.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		rule.fromResponse(resp.Data)
+		rule.setRESTClient(a.rest)
 		rule.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, rule)
 			if err != nil {
@@ -331,24 +364,29 @@ func (a *securityRulesClientAdapter) Create(ctx context.Context, rule *SecurityR
 		return rule, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return rule, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return rule, newHTTPError(resp, rule.fieldSetters())
 	}
 	return rule, nil
 }
 
 // Get fetches a SecurityRule by Ref and returns a freshly hydrated wrapper.
-func (a *securityRulesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*SecurityRule, error) {
+func (a *securityRulesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *SecurityRule, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupRulesClient.Get", "SecurityRule", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, securityGroupID, securityRuleID, err := securityRuleIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpcID, securityGroupID, securityRuleID, rp)
 	out := &SecurityRule{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -373,13 +411,16 @@ func (a *securityRulesClientAdapter) Get(ctx context.Context, ref Ref, opts ...C
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *securityRulesClientAdapter) Update(ctx context.Context, rule *SecurityRule, opts ...CallOption) (*SecurityRule, error) {
+func (a *securityRulesClientAdapter) Update(ctx context.Context, rule *SecurityRule, opts ...CallOption) (_ *SecurityRule, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupRulesClient.Update", "SecurityRule", rule)
+	defer func() { op.end(err) }()
+
 	if err := rule.Err(); err != nil {
 		return rule, err
 	}
@@ -390,11 +431,13 @@ func (a *securityRulesClientAdapter) Update(ctx context.Context, rule *SecurityR
 		return rule, fmt.Errorf("Update: security rule has no SecurityGroup — call InSecurityGroup first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(rule.Version())
 	resp, err := a.low.Update(ctx, rule.ProjectID(), rule.VPCID(), rule.SecurityGroupID(), rule.ID(), rule.toRequest(), rp)
 	populateHTTPEnvelope(&rule.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		rule.fromResponse(resp.Data)
+		rule.setRESTClient(a.rest)
 		rule.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, rule)
 			if err != nil {
@@ -410,43 +453,51 @@ func (a *securityRulesClientAdapter) Update(ctx context.Context, rule *SecurityR
 		return rule, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return rule, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return rule, newUpdateError(resp, rp, rule.fieldSetters())
 	}
 	return rule, nil
 }
 
 // Delete removes the SecurityRule identified by Ref.
-func (a *securityRulesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *securityRulesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "SecurityGroupRulesClient.Delete", "SecurityRule", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, securityGroupID, securityRuleID, err := securityRuleIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpcID, securityGroupID, securityRuleID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of SecurityRule in the given parent scope.
-func (a *securityRulesClientAdapter) List(ctx context.Context, sg Ref, opts ...CallOption) (*List[*SecurityRule], error) {
+func (a *securityRulesClientAdapter) List(ctx context.Context, sg Ref, opts ...CallOption) (_ *List[*SecurityRule], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "SecurityGroupRulesClient.List", "SecurityRule", sg)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, securityGroupID, err := securityGroupIDsFromRef(sg)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, vpcID, securityGroupID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*SecurityRule
 	if resp != nil && resp.Data != nil {
@@ -454,6 +505,7 @@ func (a *securityRulesClientAdapter) List(ctx context.Context, sg Ref, opts ...C
 		for i := range resp.Data.Values {
 			rule := &SecurityRule{}
 			rule.fromResponse(&resp.Data.Values[i])
+			rule.setRESTClient(a.rest)
 			rule.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, rule)
 				if err != nil {
@@ -484,7 +536,7 @@ func (a *securityRulesClientAdapter) List(ctx context.Context, sg Ref, opts ...C
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*SecurityRule
 		if pageResp != nil && pageResp.Data != nil {
@@ -492,6 +544,7 @@ func (a *securityRulesClientAdapter) List(ctx context.Context, sg Ref, opts ...C
 			for i := range pageResp.Data.Values {
 				item := &SecurityRule{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
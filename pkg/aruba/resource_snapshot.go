@@ -165,6 +165,12 @@ func (s *Snapshot) toRequest() types.SnapshotRequest {
 	}
 }
 
+// snapshotFieldSetters maps the wire paths of the Snapshot request to its setters.
+var snapshotFieldSetters = regionalFieldSetters("Snapshot", map[string]string{
+	"properties.billingPeriod": "BilledBy",
+	"properties.volume":        "FromVolume",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (s *Snapshot) fromResponse(resp *types.SnapshotResponse) {
 	if resp == nil {
@@ -267,7 +273,10 @@ func newSnapshotsClientAdapter(rest *restclient.Client) *snapshotsClientAdapter
 }
 
 // Create posts a new Snapshot to the API and hydrates the wrapper from the response.
-func (a *snapshotsClientAdapter) Create(ctx context.Context, snap *Snapshot, opts ...CallOption) (*Snapshot, error) {
+func (a *snapshotsClientAdapter) Create(ctx context.Context, snap *Snapshot, opts ...CallOption) (_ *Snapshot, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SnapshotsClient.Create", "Snapshot", snap)
+	defer func() { op.end(err) }()
+
 	if err := snap.Err(); err != nil {
 		return snap, err
 	}
@@ -275,11 +284,15 @@ func (a *snapshotsClientAdapter) Create(ctx context.Context, snap *Snapshot, opt
 		return snap, fmt.Errorf("Create: Snapshot has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, snap.ProjectID(), snap.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, snap, a.List, func(ctx context.Context) (*types.Response[types.SnapshotResponse], error) {
+		return a.low.Create(ctx, snap.ProjectID(), snap.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&snap.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		snap.fromResponse(resp.Data)
+		snap.setRESTClient(a.rest)
 		snap.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, snap)
 			if err != nil {
@@ -295,24 +308,29 @@ func (a *snapshotsClientAdapter) Create(ctx context.Context, snap *Snapshot, opt
 		return snap, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return snap, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return snap, newHTTPError(resp, snapshotFieldSetters)
 	}
 	return snap, nil
 }
 
 // Get fetches a Snapshot by Ref and returns a freshly hydrated wrapper.
-func (a *snapshotsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Snapshot, error) {
+func (a *snapshotsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Snapshot, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SnapshotsClient.Get", "Snapshot", ref)
+	defer func() { op.end(err) }()
+
 	projectID, snapshotID, err := snapshotIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, snapshotID, rp)
 	out := &Snapshot{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -331,13 +349,16 @@ func (a *snapshotsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallO
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *snapshotsClientAdapter) Update(ctx context.Context, snap *Snapshot, opts ...CallOption) (*Snapshot, error) {
+func (a *snapshotsClientAdapter) Update(ctx context.Context, snap *Snapshot, opts ...CallOption) (_ *Snapshot, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SnapshotsClient.Update", "Snapshot", snap)
+	defer func() { op.end(err) }()
+
 	if err := snap.Err(); err != nil {
 		return snap, err
 	}
@@ -348,11 +369,13 @@ func (a *snapshotsClientAdapter) Update(ctx context.Context, snap *Snapshot, opt
 		return snap, fmt.Errorf("Update: Snapshot has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(snap.Version())
 	resp, err := a.low.Update(ctx, snap.ProjectID(), snap.ID(), snap.toRequest(), rp)
 	populateHTTPEnvelope(&snap.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		snap.fromResponse(resp.Data)
+		snap.setRESTClient(a.rest)
 		snap.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, snap)
 			if err != nil {
@@ -368,43 +391,51 @@ func (a *snapshotsClientAdapter) Update(ctx context.Context, snap *Snapshot, opt
 		return snap, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return snap, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return snap, newUpdateError(resp, rp, snapshotFieldSetters)
 	}
 	return snap, nil
 }
 
 // Delete removes the Snapshot identified by Ref.
-func (a *snapshotsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *snapshotsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "SnapshotsClient.Delete", "Snapshot", ref)
+	defer func() { op.end(err) }()
+
 	projectID, snapshotID, err := snapshotIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, snapshotID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Snapshot in the given parent scope.
-func (a *snapshotsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*Snapshot], error) {
+func (a *snapshotsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*Snapshot], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "SnapshotsClient.List", "Snapshot", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Snapshot
 	if resp != nil && resp.Data != nil {
@@ -412,6 +443,7 @@ func (a *snapshotsClientAdapter) List(ctx context.Context, project Ref, opts ...
 		for i := range resp.Data.Values {
 			snap := &Snapshot{}
 			snap.fromResponse(&resp.Data.Values[i])
+			snap.setRESTClient(a.rest)
 			snap.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, snap)
 				if err != nil {
@@ -436,7 +468,7 @@ func (a *snapshotsClientAdapter) List(ctx context.Context, project Ref, opts ...
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Snapshot
 		if pageResp != nil && pageResp.Data != nil {
@@ -444,6 +476,7 @@ func (a *snapshotsClientAdapter) List(ctx context.Context, project Ref, opts ...
 			for i := range pageResp.Data.Values {
 				item := &Snapshot{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
@@ -170,6 +170,14 @@ func (b *StorageBackup) toRequest() types.StorageBackupRequest {
 	}
 }
 
+// storageBackupFieldSetters maps the wire paths of the StorageBackup request to its setters.
+var storageBackupFieldSetters = regionalFieldSetters("StorageBackup", map[string]string{
+	"properties.type":          "OfType",
+	"properties.sourceVolume":  "FromVolume",
+	"properties.retentionDays": "RetainedForDays",
+	"properties.billingPeriod": "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (b *StorageBackup) fromResponse(resp *types.StorageBackupResponse) {
 	if resp == nil {
@@ -263,7 +271,10 @@ func newStorageBackupsClientAdapter(rest *restclient.Client) *storageBackupsClie
 }
 
 // Create posts a new StorageBackup to the API and hydrates the wrapper from the response.
-func (a *storageBackupsClientAdapter) Create(ctx context.Context, b *StorageBackup, opts ...CallOption) (*StorageBackup, error) {
+func (a *storageBackupsClientAdapter) Create(ctx context.Context, b *StorageBackup, opts ...CallOption) (_ *StorageBackup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageBackupsClient.Create", "StorageBackup", b)
+	defer func() { op.end(err) }()
+
 	if err := b.Err(); err != nil {
 		return b, err
 	}
@@ -271,11 +282,15 @@ func (a *storageBackupsClientAdapter) Create(ctx context.Context, b *StorageBack
 		return b, fmt.Errorf("Create: StorageBackup has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, b.ProjectID(), b.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, b, a.List, func(ctx context.Context) (*types.Response[types.StorageBackupResponse], error) {
+		return a.low.Create(ctx, b.ProjectID(), b.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&b.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		b.fromResponse(resp.Data)
+		b.setRESTClient(a.rest)
 		b.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, b)
 			if err != nil {
@@ -291,24 +306,29 @@ func (a *storageBackupsClientAdapter) Create(ctx context.Context, b *StorageBack
 		return b, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return b, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return b, newHTTPError(resp, storageBackupFieldSetters)
 	}
 	return b, nil
 }
 
 // Get fetches a StorageBackup by Ref and returns a freshly hydrated wrapper.
-func (a *storageBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*StorageBackup, error) {
+func (a *storageBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *StorageBackup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageBackupsClient.Get", "StorageBackup", ref)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, err := backupIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, backupID, rp)
 	out := &StorageBackup{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -327,13 +347,16 @@ func (a *storageBackupsClientAdapter) Get(ctx context.Context, ref Ref, opts ...
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *storageBackupsClientAdapter) Update(ctx context.Context, b *StorageBackup, opts ...CallOption) (*StorageBackup, error) {
+func (a *storageBackupsClientAdapter) Update(ctx context.Context, b *StorageBackup, opts ...CallOption) (_ *StorageBackup, err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageBackupsClient.Update", "StorageBackup", b)
+	defer func() { op.end(err) }()
+
 	if err := b.Err(); err != nil {
 		return b, err
 	}
@@ -344,11 +367,13 @@ func (a *storageBackupsClientAdapter) Update(ctx context.Context, b *StorageBack
 		return b, fmt.Errorf("Update: StorageBackup has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(b.Version())
 	resp, err := a.low.Update(ctx, b.ProjectID(), b.ID(), b.toRequest(), rp)
 	populateHTTPEnvelope(&b.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		b.fromResponse(resp.Data)
+		b.setRESTClient(a.rest)
 		b.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, b)
 			if err != nil {
@@ -364,43 +389,51 @@ func (a *storageBackupsClientAdapter) Update(ctx context.Context, b *StorageBack
 		return b, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return b, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return b, newUpdateError(resp, rp, storageBackupFieldSetters)
 	}
 	return b, nil
 }
 
 // Delete removes the StorageBackup identified by Ref.
-func (a *storageBackupsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *storageBackupsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageBackupsClient.Delete", "StorageBackup", ref)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, err := backupIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, backupID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of StorageBackup entries in the given parent scope.
-func (a *storageBackupsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*StorageBackup], error) {
+func (a *storageBackupsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*StorageBackup], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "StorageBackupsClient.List", "StorageBackup", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*StorageBackup
 	if resp != nil && resp.Data != nil {
@@ -408,6 +441,7 @@ func (a *storageBackupsClientAdapter) List(ctx context.Context, project Ref, opt
 		for i := range resp.Data.Values {
 			bkp := &StorageBackup{}
 			bkp.fromResponse(&resp.Data.Values[i])
+			bkp.setRESTClient(a.rest)
 			bkp.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, bkp)
 				if err != nil {
@@ -432,7 +466,7 @@ func (a *storageBackupsClientAdapter) List(ctx context.Context, project Ref, opt
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*StorageBackup
 		if pageResp != nil && pageResp.Data != nil {
@@ -440,6 +474,7 @@ func (a *storageBackupsClientAdapter) List(ctx context.Context, project Ref, opt
 			for i := range pageResp.Data.Values {
 				item := &StorageBackup{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
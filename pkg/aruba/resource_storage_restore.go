@@ -117,6 +117,11 @@ func (r *StorageRestore) toRequest() types.StorageRestoreRequest {
 	}
 }
 
+// storageRestoreFieldSetters maps the wire paths of the StorageRestore request to its setters.
+var storageRestoreFieldSetters = regionalFieldSetters("StorageRestore", map[string]string{
+	"properties.destinationVolume": "ToVolume",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (r *StorageRestore) fromResponse(resp *types.StorageRestoreResponse) {
 	if resp == nil {
@@ -199,7 +204,10 @@ func newStorageRestoresClientAdapter(rest *restclient.Client) *storageRestoresCl
 }
 
 // Create posts a new StorageRestore to the API and hydrates the wrapper from the response.
-func (a *storageRestoresClientAdapter) Create(ctx context.Context, r *StorageRestore, opts ...CallOption) (*StorageRestore, error) {
+func (a *storageRestoresClientAdapter) Create(ctx context.Context, r *StorageRestore, opts ...CallOption) (_ *StorageRestore, err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageRestoreClient.Create", "StorageRestore", r)
+	defer func() { op.end(err) }()
+
 	if err := r.Err(); err != nil {
 		return r, err
 	}
@@ -210,11 +218,15 @@ func (a *storageRestoresClientAdapter) Create(ctx context.Context, r *StorageRes
 		return r, fmt.Errorf("Create: StorageRestore has no target — call ToVolume first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, r.ProjectID(), r.BackupID(), r.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, r, a.List, func(ctx context.Context) (*types.Response[types.StorageRestoreResponse], error) {
+		return a.low.Create(ctx, r.ProjectID(), r.BackupID(), r.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&r.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		r.fromResponse(resp.Data)
+		r.setRESTClient(a.rest)
 		r.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, r)
 			if err != nil {
@@ -230,24 +242,29 @@ func (a *storageRestoresClientAdapter) Create(ctx context.Context, r *StorageRes
 		return r, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return r, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return r, newHTTPError(resp, storageRestoreFieldSetters)
 	}
 	return r, nil
 }
 
 // Get fetches a StorageRestore by Ref and returns a freshly hydrated wrapper.
-func (a *storageRestoresClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*StorageRestore, error) {
+func (a *storageRestoresClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *StorageRestore, err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageRestoreClient.Get", "StorageRestore", ref)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, restoreID, err := restoreIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, backupID, restoreID, rp)
 	out := &StorageRestore{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -269,7 +286,7 @@ func (a *storageRestoresClientAdapter) Get(ctx context.Context, ref Ref, opts ..
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
@@ -277,7 +294,10 @@ func (a *storageRestoresClientAdapter) Get(ctx context.Context, ref Ref, opts ..
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
 // NOTE: platform support for PUT on restore resources is not currently documented;
 // callers may receive a 4xx response. Prefer Create+Delete workflows where possible.
-func (a *storageRestoresClientAdapter) Update(ctx context.Context, r *StorageRestore, opts ...CallOption) (*StorageRestore, error) {
+func (a *storageRestoresClientAdapter) Update(ctx context.Context, r *StorageRestore, opts ...CallOption) (_ *StorageRestore, err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageRestoreClient.Update", "StorageRestore", r)
+	defer func() { op.end(err) }()
+
 	if err := r.Err(); err != nil {
 		return r, err
 	}
@@ -288,11 +308,13 @@ func (a *storageRestoresClientAdapter) Update(ctx context.Context, r *StorageRes
 		return r, fmt.Errorf("Update: StorageRestore has no parent backup — call FromBackup first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(r.Version())
 	resp, err := a.low.Update(ctx, r.ProjectID(), r.BackupID(), r.ID(), r.toRequest(), rp)
 	populateHTTPEnvelope(&r.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		r.fromResponse(resp.Data)
+		r.setRESTClient(a.rest)
 		r.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, r)
 			if err != nil {
@@ -308,43 +330,51 @@ func (a *storageRestoresClientAdapter) Update(ctx context.Context, r *StorageRes
 		return r, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return r, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return r, newUpdateError(resp, rp, storageRestoreFieldSetters)
 	}
 	return r, nil
 }
 
 // Delete removes the StorageRestore identified by Ref.
-func (a *storageRestoresClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *storageRestoresClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "StorageRestoreClient.Delete", "StorageRestore", ref)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, restoreID, err := restoreIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, backupID, restoreID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of StorageRestore entries for the given backup.
-func (a *storageRestoresClientAdapter) List(ctx context.Context, backup Ref, opts ...CallOption) (*List[*StorageRestore], error) {
+func (a *storageRestoresClientAdapter) List(ctx context.Context, backup Ref, opts ...CallOption) (_ *List[*StorageRestore], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "StorageRestoreClient.List", "StorageRestore", backup)
+	defer func() { op.end(err) }()
+
 	projectID, backupID, err := backupIDsFromRef(backup)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, backupID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*StorageRestore
 	if resp != nil && resp.Data != nil {
@@ -352,6 +382,7 @@ func (a *storageRestoresClientAdapter) List(ctx context.Context, backup Ref, opt
 		for i := range resp.Data.Values {
 			v := &StorageRestore{}
 			v.fromResponse(&resp.Data.Values[i])
+			v.setRESTClient(a.rest)
 			v.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, v)
 				if err != nil {
@@ -379,7 +410,7 @@ func (a *storageRestoresClientAdapter) List(ctx context.Context, backup Ref, opt
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*StorageRestore
 		if pageResp != nil && pageResp.Data != nil {
@@ -387,6 +418,7 @@ func (a *storageRestoresClientAdapter) List(ctx context.Context, backup Ref, opt
 			for i := range pageResp.Data.Values {
 				item := &StorageRestore{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
@@ -167,6 +167,18 @@ func (s *Subnet) toRequest() types.SubnetRequest {
 	}
 }
 
+// subnetFieldSetters maps the wire paths of the Subnet request to its setters.
+var subnetFieldSetters = regionalFieldSetters("Subnet", map[string]string{
+	"properties.type":         "OfType",
+	"properties.default":      "AsDefault",
+	"properties.network":      "WithCIDR",
+	"properties.dhcp":         "WithDHCP",
+	"properties.dhcp.enabled": "SubnetDHCPCommon.Enabled",
+	"properties.dhcp.range":   "SubnetDHCPCommon.WithRange",
+	"properties.dhcp.routes":  "SubnetDHCPCommon.WithRoutes",
+	"properties.dhcp.dns":     "SubnetDHCPCommon.WithDNSServers",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (s *Subnet) fromResponse(resp *types.SubnetResponse) {
 	if resp == nil {
@@ -258,7 +270,10 @@ func newSubnetsClientAdapter(rest *restclient.Client) *subnetsClientAdapter {
 }
 
 // Create posts a new Subnet to the API and hydrates the wrapper from the response.
-func (a *subnetsClientAdapter) Create(ctx context.Context, s *Subnet, opts ...CallOption) (*Subnet, error) {
+func (a *subnetsClientAdapter) Create(ctx context.Context, s *Subnet, opts ...CallOption) (_ *Subnet, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SubnetsClient.Create", "Subnet", s)
+	defer func() { op.end(err) }()
+
 	if err := s.Err(); err != nil {
 		return s, err
 	}
@@ -266,11 +281,15 @@ func (a *subnetsClientAdapter) Create(ctx context.Context, s *Subnet, opts ...Ca
 		return s, fmt.Errorf("Create: subnet has no VPC — call InVPC first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, s.ProjectID(), s.VPCID(), s.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, s, a.List, func(ctx context.Context) (*types.Response[types.SubnetResponse], error) {
+		return a.low.Create(ctx, s.ProjectID(), s.VPCID(), s.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&s.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		s.fromResponse(resp.Data)
+		s.setRESTClient(a.rest)
 		s.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, s)
 			if err != nil {
@@ -286,24 +305,29 @@ func (a *subnetsClientAdapter) Create(ctx context.Context, s *Subnet, opts ...Ca
 		return s, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return s, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return s, newHTTPError(resp, subnetFieldSetters)
 	}
 	return s, nil
 }
 
 // Get fetches a Subnet by Ref and returns a freshly hydrated wrapper.
-func (a *subnetsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*Subnet, error) {
+func (a *subnetsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *Subnet, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SubnetsClient.Get", "Subnet", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, subnetID, err := subnetIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpcID, subnetID, rp)
 	out := &Subnet{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -321,13 +345,16 @@ func (a *subnetsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOpt
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *subnetsClientAdapter) Update(ctx context.Context, s *Subnet, opts ...CallOption) (*Subnet, error) {
+func (a *subnetsClientAdapter) Update(ctx context.Context, s *Subnet, opts ...CallOption) (_ *Subnet, err error) {
+	ctx, op := startOperation(ctx, a.rest, "SubnetsClient.Update", "Subnet", s)
+	defer func() { op.end(err) }()
+
 	if err := s.Err(); err != nil {
 		return s, err
 	}
@@ -338,11 +365,13 @@ func (a *subnetsClientAdapter) Update(ctx context.Context, s *Subnet, opts ...Ca
 		return s, fmt.Errorf("Update: subnet has no VPC — call InVPC first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(s.Version())
 	resp, err := a.low.Update(ctx, s.ProjectID(), s.VPCID(), s.ID(), s.toRequest(), rp)
 	populateHTTPEnvelope(&s.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		s.fromResponse(resp.Data)
+		s.setRESTClient(a.rest)
 		s.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, s)
 			if err != nil {
@@ -358,43 +387,51 @@ func (a *subnetsClientAdapter) Update(ctx context.Context, s *Subnet, opts ...Ca
 		return s, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return s, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return s, newUpdateError(resp, rp, subnetFieldSetters)
 	}
 	return s, nil
 }
 
 // Delete removes the Subnet identified by Ref.
-func (a *subnetsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *subnetsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "SubnetsClient.Delete", "Subnet", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, subnetID, err := subnetIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpcID, subnetID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Subnet in the given parent scope.
-func (a *subnetsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOption) (*List[*Subnet], error) {
+func (a *subnetsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOption) (_ *List[*Subnet], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "SubnetsClient.List", "Subnet", vpc)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, err := vpcIDsFromRef(vpc)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, vpcID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*Subnet
 	if resp != nil && resp.Data != nil {
@@ -402,6 +439,7 @@ func (a *subnetsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOp
 		for i := range resp.Data.Values {
 			s := &Subnet{}
 			s.fromResponse(&resp.Data.Values[i])
+			s.setRESTClient(a.rest)
 			s.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, s)
 				if err != nil {
@@ -427,7 +465,7 @@ func (a *subnetsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOp
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*Subnet
 		if pageResp != nil && pageResp.Data != nil {
@@ -435,6 +473,7 @@ func (a *subnetsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOp
 			for i := range pageResp.Data.Values {
 				item := &Subnet{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
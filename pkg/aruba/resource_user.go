@@ -125,6 +125,12 @@ func (u *User) toRequest() types.UserRequest {
 	}
 }
 
+// userFieldSetters maps the wire paths of the User request to its setters.
+var userFieldSetters = newFieldSetters("User", map[string]string{
+	"username": "WithUsername",
+	"password": "WithPassword",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (u *User) fromResponse(resp *types.UserResponse) {
 	if resp == nil {
@@ -210,7 +216,10 @@ func newUsersClientAdapter(rest *restclient.Client) *usersClientAdapter {
 }
 
 // Create posts a new User to the API and hydrates the wrapper from the response.
-func (a *usersClientAdapter) Create(ctx context.Context, u *User, opts ...CallOption) (*User, error) {
+func (a *usersClientAdapter) Create(ctx context.Context, u *User, opts ...CallOption) (_ *User, err error) {
+	ctx, op := startOperation(ctx, a.rest, "UsersClient.Create", "User", u)
+	defer func() { op.end(err) }()
+
 	if err := u.Err(); err != nil {
 		return u, err
 	}
@@ -227,11 +236,15 @@ func (a *usersClientAdapter) Create(ctx context.Context, u *User, opts ...CallOp
 		return u, fmt.Errorf("Create: password is required — call WithPassword first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, u.ProjectID(), u.DBaaSID(), u.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, u, a.List, func(ctx context.Context) (*types.Response[types.UserResponse], error) {
+		return a.low.Create(ctx, u.ProjectID(), u.DBaaSID(), u.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&u.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		u.fromResponse(resp.Data)
+		u.setRESTClient(a.rest)
 		u.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, u)
 			if err != nil {
@@ -247,13 +260,16 @@ func (a *usersClientAdapter) Create(ctx context.Context, u *User, opts ...CallOp
 		return u, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return u, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return u, newHTTPError(resp, userFieldSetters)
 	}
 	return u, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *usersClientAdapter) Update(ctx context.Context, u *User, opts ...CallOption) (*User, error) {
+func (a *usersClientAdapter) Update(ctx context.Context, u *User, opts ...CallOption) (_ *User, err error) {
+	ctx, op := startOperation(ctx, a.rest, "UsersClient.Update", "User", u)
+	defer func() { op.end(err) }()
+
 	if err := u.Err(); err != nil {
 		return u, err
 	}
@@ -270,11 +286,13 @@ func (a *usersClientAdapter) Update(ctx context.Context, u *User, opts ...CallOp
 		return u, fmt.Errorf("Update: password is required — call WithPassword first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(u.Version())
 	resp, err := a.low.Update(ctx, u.ProjectID(), u.DBaaSID(), u.ID(), u.toRequest(), rp)
 	populateHTTPEnvelope(&u.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		u.fromResponse(resp.Data)
+		u.setRESTClient(a.rest)
 		u.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, u)
 			if err != nil {
@@ -290,18 +308,22 @@ func (a *usersClientAdapter) Update(ctx context.Context, u *User, opts ...CallOp
 		return u, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return u, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return u, newUpdateError(resp, rp, userFieldSetters)
 	}
 	return u, nil
 }
 
 // Get fetches a User by Ref and returns a freshly hydrated wrapper.
-func (a *usersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*User, error) {
+func (a *usersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *User, err error) {
+	ctx, op := startOperation(ctx, a.rest, "UsersClient.Get", "User", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, userID, err := userIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, dbaasID, userID, rp)
 	out := &User{}
@@ -312,6 +334,7 @@ func (a *usersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOptio
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -327,43 +350,51 @@ func (a *usersClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOptio
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Delete removes the User identified by Ref.
-func (a *usersClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *usersClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "UsersClient.Delete", "User", ref)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, userID, err := userIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, dbaasID, userID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of Users in the given DBaaS scope.
-func (a *usersClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOption) (*List[*User], error) {
+func (a *usersClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOption) (_ *List[*User], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "UsersClient.List", "User", dbaas)
+	defer func() { op.end(err) }()
+
 	projectID, dbaasID, err := dbaasIDsFromRef(dbaas)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, dbaasID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*User
 	if resp != nil && resp.Data != nil {
@@ -373,6 +404,7 @@ func (a *usersClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOp
 			u.dbaasID = dbaasID
 			u.projectID = projectID
 			u.fromResponse(&resp.Data.Values[i])
+			u.setRESTClient(a.rest)
 			u.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, u)
 				if err != nil {
@@ -394,7 +426,7 @@ func (a *usersClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOp
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*User
 		if pageResp != nil && pageResp.Data != nil {
@@ -404,6 +436,7 @@ func (a *usersClientAdapter) List(ctx context.Context, dbaas Ref, opts ...CallOp
 				item.dbaasID = dbaasID
 				item.projectID = projectID
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
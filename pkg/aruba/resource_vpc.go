@@ -138,6 +138,12 @@ func (v *VPC) toRequest() types.VPCRequest {
 	}
 }
 
+// vpcFieldSetters maps the wire paths of the VPC request to its setters.
+var vpcFieldSetters = regionalFieldSetters("VPC", map[string]string{
+	"properties.properties.default": "AsDefault",
+	"properties.properties.preset":  "WithPreset",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (v *VPC) fromResponse(resp *types.VPCResponse) {
 	if resp == nil {
@@ -203,7 +209,10 @@ func newVPCsClientAdapter(rest *restclient.Client) *vpcsClientAdapter {
 }
 
 // Create posts a new VPC to the API and hydrates the wrapper from the response.
-func (a *vpcsClientAdapter) Create(ctx context.Context, v *VPC, opts ...CallOption) (*VPC, error) {
+func (a *vpcsClientAdapter) Create(ctx context.Context, v *VPC, opts ...CallOption) (_ *VPC, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCsClient.Create", "VPC", v)
+	defer func() { op.end(err) }()
+
 	if err := v.Err(); err != nil {
 		return v, err
 	}
@@ -211,11 +220,15 @@ func (a *vpcsClientAdapter) Create(ctx context.Context, v *VPC, opts ...CallOpti
 		return v, fmt.Errorf("Create: VPC has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, v.ProjectID(), v.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, v, a.List, func(ctx context.Context) (*types.Response[types.VPCResponse], error) {
+		return a.low.Create(ctx, v.ProjectID(), v.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&v.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		v.fromResponse(resp.Data)
+		v.setRESTClient(a.rest)
 		v.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, v)
 			if err != nil {
@@ -231,18 +244,22 @@ func (a *vpcsClientAdapter) Create(ctx context.Context, v *VPC, opts ...CallOpti
 		return v, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return v, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return v, newHTTPError(resp, vpcFieldSetters)
 	}
 	return v, nil
 }
 
 // Get fetches a VPC by Ref and returns a freshly hydrated wrapper.
-func (a *vpcsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*VPC, error) {
+func (a *vpcsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *VPC, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCsClient.Get", "VPC", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, err := vpcIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpcID, rp)
 	out := &VPC{}
@@ -250,6 +267,7 @@ func (a *vpcsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -265,13 +283,16 @@ func (a *vpcsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *vpcsClientAdapter) Update(ctx context.Context, v *VPC, opts ...CallOption) (*VPC, error) {
+func (a *vpcsClientAdapter) Update(ctx context.Context, v *VPC, opts ...CallOption) (_ *VPC, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCsClient.Update", "VPC", v)
+	defer func() { op.end(err) }()
+
 	if err := v.Err(); err != nil {
 		return v, err
 	}
@@ -282,11 +303,13 @@ func (a *vpcsClientAdapter) Update(ctx context.Context, v *VPC, opts ...CallOpti
 		return v, fmt.Errorf("Update: VPC has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(v.Version())
 	resp, err := a.low.Update(ctx, v.ProjectID(), v.ID(), v.toRequest(), rp)
 	populateHTTPEnvelope(&v.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		v.fromResponse(resp.Data)
+		v.setRESTClient(a.rest)
 		v.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, v)
 			if err != nil {
@@ -302,43 +325,51 @@ func (a *vpcsClientAdapter) Update(ctx context.Context, v *VPC, opts ...CallOpti
 		return v, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return v, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return v, newUpdateError(resp, rp, vpcFieldSetters)
 	}
 	return v, nil
 }
 
 // Delete removes the VPC identified by Ref.
-func (a *vpcsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *vpcsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCsClient.Delete", "VPC", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, err := vpcIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpcID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of VPC in the given parent scope.
-func (a *vpcsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*VPC], error) {
+func (a *vpcsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*VPC], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "VPCsClient.List", "VPC", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*VPC
 	if resp != nil && resp.Data != nil {
@@ -347,6 +378,7 @@ func (a *vpcsClientAdapter) List(ctx context.Context, project Ref, opts ...CallO
 			v := &VPC{}
 			v.projectID = projectID
 			v.fromResponse(&resp.Data.Values[i])
+			v.setRESTClient(a.rest)
 			v.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, v)
 				if err != nil {
@@ -368,7 +400,7 @@ func (a *vpcsClientAdapter) List(ctx context.Context, project Ref, opts ...CallO
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*VPC
 		if pageResp != nil && pageResp.Data != nil {
@@ -377,6 +409,7 @@ func (a *vpcsClientAdapter) List(ctx context.Context, project Ref, opts ...CallO
 				item := &VPC{}
 				item.projectID = projectID
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
@@ -128,6 +128,11 @@ func (p *VPCPeering) toRequest() types.VPCPeeringRequest {
 	}
 }
 
+// vpcPeeringFieldSetters maps the wire paths of the VPCPeering request to its setters.
+var vpcPeeringFieldSetters = regionalFieldSetters("VPCPeering", map[string]string{
+	"properties.remoteVpc": "PeeredWith",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (p *VPCPeering) fromResponse(resp *types.VPCPeeringResponse) {
 	if resp == nil {
@@ -206,7 +211,10 @@ func newVPCPeeringsClientAdapter(rest *restclient.Client) *vpcPeeringsClientAdap
 }
 
 // Create posts a new VPCPeering to the API and hydrates the wrapper from the response.
-func (a *vpcPeeringsClientAdapter) Create(ctx context.Context, peering *VPCPeering, opts ...CallOption) (*VPCPeering, error) {
+func (a *vpcPeeringsClientAdapter) Create(ctx context.Context, peering *VPCPeering, opts ...CallOption) (_ *VPCPeering, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringsClient.Create", "VPCPeering", peering)
+	defer func() { op.end(err) }()
+
 	if err := peering.Err(); err != nil {
 		return peering, err
 	}
@@ -214,11 +222,15 @@ func (a *vpcPeeringsClientAdapter) Create(ctx context.Context, peering *VPCPeeri
 		return peering, fmt.Errorf("Create: VPC peering has no VPC — call InVPC first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, peering.ProjectID(), peering.VPCID(), peering.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, peering, a.List, func(ctx context.Context) (*types.Response[types.VPCPeeringResponse], error) {
+		return a.low.Create(ctx, peering.ProjectID(), peering.VPCID(), peering.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&peering.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		peering.fromResponse(resp.Data)
+		peering.setRESTClient(a.rest)
 		peering.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, peering)
 			if err != nil {
@@ -234,24 +246,29 @@ func (a *vpcPeeringsClientAdapter) Create(ctx context.Context, peering *VPCPeeri
 		return peering, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return peering, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return peering, newHTTPError(resp, vpcPeeringFieldSetters)
 	}
 	return peering, nil
 }
 
 // Get fetches a VPCPeering by Ref and returns a freshly hydrated wrapper.
-func (a *vpcPeeringsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*VPCPeering, error) {
+func (a *vpcPeeringsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *VPCPeering, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringsClient.Get", "VPCPeering", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, vpcPeeringID, err := vpcPeeringIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpcID, vpcPeeringID, rp)
 	out := &VPCPeering{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -273,13 +290,16 @@ func (a *vpcPeeringsClientAdapter) Get(ctx context.Context, ref Ref, opts ...Cal
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *vpcPeeringsClientAdapter) Update(ctx context.Context, peering *VPCPeering, opts ...CallOption) (*VPCPeering, error) {
+func (a *vpcPeeringsClientAdapter) Update(ctx context.Context, peering *VPCPeering, opts ...CallOption) (_ *VPCPeering, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringsClient.Update", "VPCPeering", peering)
+	defer func() { op.end(err) }()
+
 	if err := peering.Err(); err != nil {
 		return peering, err
 	}
@@ -290,11 +310,13 @@ func (a *vpcPeeringsClientAdapter) Update(ctx context.Context, peering *VPCPeeri
 		return peering, fmt.Errorf("Update: VPC peering has no VPC — call InVPC first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(peering.Version())
 	resp, err := a.low.Update(ctx, peering.ProjectID(), peering.VPCID(), peering.ID(), peering.toRequest(), rp)
 	populateHTTPEnvelope(&peering.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		peering.fromResponse(resp.Data)
+		peering.setRESTClient(a.rest)
 		peering.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, peering)
 			if err != nil {
@@ -310,43 +332,51 @@ func (a *vpcPeeringsClientAdapter) Update(ctx context.Context, peering *VPCPeeri
 		return peering, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return peering, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return peering, newUpdateError(resp, rp, vpcPeeringFieldSetters)
 	}
 	return peering, nil
 }
 
 // Delete removes the VPCPeering identified by Ref.
-func (a *vpcPeeringsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *vpcPeeringsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringsClient.Delete", "VPCPeering", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, vpcPeeringID, err := vpcPeeringIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpcID, vpcPeeringID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of VPCPeering in the given parent scope.
-func (a *vpcPeeringsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOption) (*List[*VPCPeering], error) {
+func (a *vpcPeeringsClientAdapter) List(ctx context.Context, vpc Ref, opts ...CallOption) (_ *List[*VPCPeering], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "VPCPeeringsClient.List", "VPCPeering", vpc)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, err := vpcIDsFromRef(vpc)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, vpcID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*VPCPeering
 	if resp != nil && resp.Data != nil {
@@ -354,6 +384,7 @@ func (a *vpcPeeringsClientAdapter) List(ctx context.Context, vpc Ref, opts ...Ca
 		for i := range resp.Data.Values {
 			p := &VPCPeering{}
 			p.fromResponse(&resp.Data.Values[i])
+			p.setRESTClient(a.rest)
 			p.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, p)
 				if err != nil {
@@ -381,7 +412,7 @@ func (a *vpcPeeringsClientAdapter) List(ctx context.Context, vpc Ref, opts ...Ca
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*VPCPeering
 		if pageResp != nil && pageResp.Data != nil {
@@ -389,6 +420,7 @@ func (a *vpcPeeringsClientAdapter) List(ctx context.Context, vpc Ref, opts ...Ca
 			for i := range pageResp.Data.Values {
 				item := &VPCPeering{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
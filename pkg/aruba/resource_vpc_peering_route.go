@@ -155,6 +155,13 @@ func (r *VPCPeeringRoute) toRequest() types.VPCPeeringRouteRequest {
 	}
 }
 
+// vpcPeeringRouteFieldSetters maps the wire paths of the VPCPeeringRoute request to its setters.
+var vpcPeeringRouteFieldSetters = regionalFieldSetters("VPCPeeringRoute", map[string]string{
+	"properties.localNetworkAddress":  "WithLocalCIDR",
+	"properties.remoteNetworkAddress": "WithRemoteCIDR",
+	"properties.billingPlan":          "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (r *VPCPeeringRoute) fromResponse(resp *types.VPCPeeringRouteResponse) {
 	if resp == nil {
@@ -241,7 +248,10 @@ func newVPCPeeringRoutesClientAdapter(rest *restclient.Client) *vpcPeeringRoutes
 }
 
 // Create posts a new VPCPeeringRoute to the API and hydrates the wrapper from the response.
-func (a *vpcPeeringRoutesClientAdapter) Create(ctx context.Context, route *VPCPeeringRoute, opts ...CallOption) (*VPCPeeringRoute, error) {
+func (a *vpcPeeringRoutesClientAdapter) Create(ctx context.Context, route *VPCPeeringRoute, opts ...CallOption) (_ *VPCPeeringRoute, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringRoutesClient.Create", "VPCPeeringRoute", route)
+	defer func() { op.end(err) }()
+
 	if err := route.Err(); err != nil {
 		return route, err
 	}
@@ -249,11 +259,15 @@ func (a *vpcPeeringRoutesClientAdapter) Create(ctx context.Context, route *VPCPe
 		return route, fmt.Errorf("Create: VPC peering route has no parent peering — call InVPCPeering first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, route.ProjectID(), route.VPCID(), route.VPCPeeringID(), route.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, route, a.List, func(ctx context.Context) (*types.Response[types.VPCPeeringRouteResponse], error) {
+		return a.low.Create(ctx, route.ProjectID(), route.VPCID(), route.VPCPeeringID(), route.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&route.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		route.fromResponse(resp.Data)
+		route.setRESTClient(a.rest)
 		route.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, route)
 			if err != nil {
@@ -269,24 +283,29 @@ func (a *vpcPeeringRoutesClientAdapter) Create(ctx context.Context, route *VPCPe
 		return route, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return route, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return route, newHTTPError(resp, vpcPeeringRouteFieldSetters)
 	}
 	return route, nil
 }
 
 // Get fetches a VPCPeeringRoute by Ref and returns a freshly hydrated wrapper.
-func (a *vpcPeeringRoutesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*VPCPeeringRoute, error) {
+func (a *vpcPeeringRoutesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *VPCPeeringRoute, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringRoutesClient.Get", "VPCPeeringRoute", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, vpcPeeringID, routeID, err := vpcPeeringRouteIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpcID, vpcPeeringID, routeID, rp)
 	out := &VPCPeeringRoute{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -311,13 +330,16 @@ func (a *vpcPeeringRoutesClientAdapter) Get(ctx context.Context, ref Ref, opts .
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *vpcPeeringRoutesClientAdapter) Update(ctx context.Context, route *VPCPeeringRoute, opts ...CallOption) (*VPCPeeringRoute, error) {
+func (a *vpcPeeringRoutesClientAdapter) Update(ctx context.Context, route *VPCPeeringRoute, opts ...CallOption) (_ *VPCPeeringRoute, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringRoutesClient.Update", "VPCPeeringRoute", route)
+	defer func() { op.end(err) }()
+
 	if err := route.Err(); err != nil {
 		return route, err
 	}
@@ -328,11 +350,13 @@ func (a *vpcPeeringRoutesClientAdapter) Update(ctx context.Context, route *VPCPe
 		return route, fmt.Errorf("Update: VPC peering route has no parent peering — call InVPCPeering first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(route.Version())
 	resp, err := a.low.Update(ctx, route.ProjectID(), route.VPCID(), route.VPCPeeringID(), route.ID(), route.toRequest(), rp)
 	populateHTTPEnvelope(&route.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		route.fromResponse(resp.Data)
+		route.setRESTClient(a.rest)
 		route.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, route)
 			if err != nil {
@@ -348,43 +372,51 @@ func (a *vpcPeeringRoutesClientAdapter) Update(ctx context.Context, route *VPCPe
 		return route, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return route, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return route, newUpdateError(resp, rp, vpcPeeringRouteFieldSetters)
 	}
 	return route, nil
 }
 
 // Delete removes the VPCPeeringRoute identified by Ref.
-func (a *vpcPeeringRoutesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *vpcPeeringRoutesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPCPeeringRoutesClient.Delete", "VPCPeeringRoute", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, vpcPeeringID, routeID, err := vpcPeeringRouteIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpcID, vpcPeeringID, routeID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of VPCPeeringRoute in the given parent scope.
-func (a *vpcPeeringRoutesClientAdapter) List(ctx context.Context, peering Ref, opts ...CallOption) (*List[*VPCPeeringRoute], error) {
+func (a *vpcPeeringRoutesClientAdapter) List(ctx context.Context, peering Ref, opts ...CallOption) (_ *List[*VPCPeeringRoute], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "VPCPeeringRoutesClient.List", "VPCPeeringRoute", peering)
+	defer func() { op.end(err) }()
+
 	projectID, vpcID, vpcPeeringID, err := vpcPeeringIDsFromRef(peering)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, vpcID, vpcPeeringID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*VPCPeeringRoute
 	if resp != nil && resp.Data != nil {
@@ -392,6 +424,7 @@ func (a *vpcPeeringRoutesClientAdapter) List(ctx context.Context, peering Ref, o
 		for i := range resp.Data.Values {
 			r := &VPCPeeringRoute{}
 			r.fromResponse(&resp.Data.Values[i])
+			r.setRESTClient(a.rest)
 			r.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, r)
 				if err != nil {
@@ -422,7 +455,7 @@ func (a *vpcPeeringRoutesClientAdapter) List(ctx context.Context, peering Ref, o
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*VPCPeeringRoute
 		if pageResp != nil && pageResp.Data != nil {
@@ -430,6 +463,7 @@ func (a *vpcPeeringRoutesClientAdapter) List(ctx context.Context, peering Ref, o
 			for i := range pageResp.Data.Values {
 				item := &VPCPeeringRoute{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
@@ -141,6 +141,12 @@ func (r *VPNRoute) toRequest() types.VPNRouteRequest {
 	}
 }
 
+// vpnRouteFieldSetters maps the wire paths of the VPNRoute request to its setters.
+var vpnRouteFieldSetters = regionalFieldSetters("VPNRoute", map[string]string{
+	"properties.cloudSubnet":  "WithCloudSubnet",
+	"properties.onPremSubnet": "WithOnPremSubnet",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (r *VPNRoute) fromResponse(resp *types.VPNRouteResponse) {
 	if resp == nil {
@@ -223,7 +229,10 @@ func newVPNRoutesClientAdapter(rest *restclient.Client) *vpnRoutesClientAdapter
 }
 
 // Create posts a new VPNRoute to the API and hydrates the wrapper from the response.
-func (a *vpnRoutesClientAdapter) Create(ctx context.Context, r *VPNRoute, opts ...CallOption) (*VPNRoute, error) {
+func (a *vpnRoutesClientAdapter) Create(ctx context.Context, r *VPNRoute, opts ...CallOption) (_ *VPNRoute, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNRoutesClient.Create", "VPNRoute", r)
+	defer func() { op.end(err) }()
+
 	if err := r.Err(); err != nil {
 		return r, err
 	}
@@ -231,11 +240,15 @@ func (a *vpnRoutesClientAdapter) Create(ctx context.Context, r *VPNRoute, opts .
 		return r, fmt.Errorf("Create: VPN route has no parent tunnel — call InVPNTunnel first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, r.ProjectID(), r.VPNTunnelID(), r.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, r, a.List, func(ctx context.Context) (*types.Response[types.VPNRouteResponse], error) {
+		return a.low.Create(ctx, r.ProjectID(), r.VPNTunnelID(), r.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&r.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		r.fromResponse(resp.Data)
+		r.setRESTClient(a.rest)
 		r.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, r)
 			if err != nil {
@@ -251,24 +264,29 @@ func (a *vpnRoutesClientAdapter) Create(ctx context.Context, r *VPNRoute, opts .
 		return r, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return r, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return r, newHTTPError(resp, vpnRouteFieldSetters)
 	}
 	return r, nil
 }
 
 // Get fetches a VPNRoute by Ref and returns a freshly hydrated wrapper.
-func (a *vpnRoutesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*VPNRoute, error) {
+func (a *vpnRoutesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *VPNRoute, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNRoutesClient.Get", "VPNRoute", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpnTunnelID, vpnRouteID, err := vpnRouteIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpnTunnelID, vpnRouteID, rp)
 	out := &VPNRoute{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -290,13 +308,16 @@ func (a *vpnRoutesClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallO
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *vpnRoutesClientAdapter) Update(ctx context.Context, r *VPNRoute, opts ...CallOption) (*VPNRoute, error) {
+func (a *vpnRoutesClientAdapter) Update(ctx context.Context, r *VPNRoute, opts ...CallOption) (_ *VPNRoute, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNRoutesClient.Update", "VPNRoute", r)
+	defer func() { op.end(err) }()
+
 	if err := r.Err(); err != nil {
 		return r, err
 	}
@@ -307,11 +328,13 @@ func (a *vpnRoutesClientAdapter) Update(ctx context.Context, r *VPNRoute, opts .
 		return r, fmt.Errorf("Update: VPN route has no parent tunnel — call InVPNTunnel first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(r.Version())
 	resp, err := a.low.Update(ctx, r.ProjectID(), r.VPNTunnelID(), r.ID(), r.toRequest(), rp)
 	populateHTTPEnvelope(&r.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		r.fromResponse(resp.Data)
+		r.setRESTClient(a.rest)
 		r.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, r)
 			if err != nil {
@@ -327,43 +350,51 @@ func (a *vpnRoutesClientAdapter) Update(ctx context.Context, r *VPNRoute, opts .
 		return r, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return r, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return r, newUpdateError(resp, rp, vpnRouteFieldSetters)
 	}
 	return r, nil
 }
 
 // Delete removes the VPNRoute identified by Ref.
-func (a *vpnRoutesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *vpnRoutesClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNRoutesClient.Delete", "VPNRoute", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpnTunnelID, vpnRouteID, err := vpnRouteIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpnTunnelID, vpnRouteID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of VPNRoute in the given parent scope.
-func (a *vpnRoutesClientAdapter) List(ctx context.Context, tunnel Ref, opts ...CallOption) (*List[*VPNRoute], error) {
+func (a *vpnRoutesClientAdapter) List(ctx context.Context, tunnel Ref, opts ...CallOption) (_ *List[*VPNRoute], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "VPNRoutesClient.List", "VPNRoute", tunnel)
+	defer func() { op.end(err) }()
+
 	projectID, vpnTunnelID, err := vpnTunnelIDsFromRef(tunnel)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, vpnTunnelID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*VPNRoute
 	if resp != nil && resp.Data != nil {
@@ -371,6 +402,7 @@ func (a *vpnRoutesClientAdapter) List(ctx context.Context, tunnel Ref, opts ...C
 		for i := range resp.Data.Values {
 			v := &VPNRoute{}
 			v.fromResponse(&resp.Data.Values[i])
+			v.setRESTClient(a.rest)
 			v.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, v)
 				if err != nil {
@@ -398,7 +430,7 @@ func (a *vpnRoutesClientAdapter) List(ctx context.Context, tunnel Ref, opts ...C
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*VPNRoute
 		if pageResp != nil && pageResp.Data != nil {
@@ -406,6 +438,7 @@ func (a *vpnRoutesClientAdapter) List(ctx context.Context, tunnel Ref, opts ...C
 			for i := range pageResp.Data.Values {
 				item := &VPNRoute{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
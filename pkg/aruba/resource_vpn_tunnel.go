@@ -245,6 +245,35 @@ func (t *VPNTunnel) toRequest() types.VPNTunnelRequest {
 	}
 }
 
+// vpnTunnelFieldSetters maps the wire paths of the VPNTunnel request to its setters.
+var vpnTunnelFieldSetters = regionalFieldSetters("VPNTunnel", map[string]string{
+	"properties.vpnType":                              "OfType",
+	"properties.vpnClientProtocol":                    "WithVPNClientProtocol",
+	"properties.ipConfigurations":                     "WithIPConfig",
+	"properties.ipConfigurations.vpc":                 "VPNIPConfig.WithVPC",
+	"properties.ipConfigurations.subnet":              "VPNIPConfig.WithSubnet",
+	"properties.ipConfigurations.publicIp":            "VPNIPConfig.WithElasticIP",
+	"properties.vpnClientSettings.ike":                "WithIKESettings",
+	"properties.vpnClientSettings.ike.lifetime":       "VPNIKE.WithLifetimeSeconds",
+	"properties.vpnClientSettings.ike.encryption":     "VPNIKE.WithEncryption",
+	"properties.vpnClientSettings.ike.hash":           "VPNIKE.WithHash",
+	"properties.vpnClientSettings.ike.dhGroup":        "VPNIKE.WithDHGroup",
+	"properties.vpnClientSettings.ike.dpdAction":      "VPNIKE.WithDPDAction",
+	"properties.vpnClientSettings.ike.dpdInterval":    "VPNIKE.WithDPDIntervalSeconds",
+	"properties.vpnClientSettings.ike.dpdTimeout":     "VPNIKE.WithDPDTimeoutSeconds",
+	"properties.vpnClientSettings.esp":                "WithESPSettings",
+	"properties.vpnClientSettings.esp.lifetime":       "VPNESP.WithLifetimeSeconds",
+	"properties.vpnClientSettings.esp.encryption":     "VPNESP.WithEncryption",
+	"properties.vpnClientSettings.esp.hash":           "VPNESP.WithHash",
+	"properties.vpnClientSettings.esp.pfs":            "VPNESP.WithPFS",
+	"properties.vpnClientSettings.psk":                "WithPSKSettings",
+	"properties.vpnClientSettings.psk.cloudSite":      "VPNPSK.WithCloudSite",
+	"properties.vpnClientSettings.psk.onPremSite":     "VPNPSK.WithOnPremSite",
+	"properties.vpnClientSettings.psk.secret":         "VPNPSK.WithKey",
+	"properties.vpnClientSettings.peerClientPublicIp": "WithPeerClientPublicIP",
+	"properties.billingPlan":                          "BilledBy",
+})
+
 // fromResponse hydrates the wrapper from a server reply. Nil-safe.
 func (t *VPNTunnel) fromResponse(resp *types.VPNTunnelResponse) {
 	if resp == nil {
@@ -368,7 +397,10 @@ func newVPNTunnelsClientAdapter(rest *restclient.Client) *vpnTunnelsClientAdapte
 }
 
 // Create posts a new VPNTunnel to the API and hydrates the wrapper from the response.
-func (a *vpnTunnelsClientAdapter) Create(ctx context.Context, t *VPNTunnel, opts ...CallOption) (*VPNTunnel, error) {
+func (a *vpnTunnelsClientAdapter) Create(ctx context.Context, t *VPNTunnel, opts ...CallOption) (_ *VPNTunnel, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNTunnelsClient.Create", "VPNTunnel", t)
+	defer func() { op.end(err) }()
+
 	if err := t.Err(); err != nil {
 		return t, err
 	}
@@ -376,11 +408,15 @@ func (a *vpnTunnelsClientAdapter) Create(ctx context.Context, t *VPNTunnel, opts
 		return t, fmt.Errorf("Create: VPN tunnel has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
-	resp, err := a.low.Create(ctx, t.ProjectID(), t.toRequest(), rp)
+	ctx = co.bind(ctx)
+	rp := co.toCreateParameters(a.rest)
+	resp, err := createWithRecovery(ctx, a.rest, rp, t, a.List, func(ctx context.Context) (*types.Response[types.VPNTunnelResponse], error) {
+		return a.low.Create(ctx, t.ProjectID(), t.toRequest(), rp)
+	})
 	populateHTTPEnvelope(&t.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		t.fromResponse(resp.Data)
+		t.setRESTClient(a.rest)
 		t.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, t)
 			if err != nil {
@@ -396,24 +432,29 @@ func (a *vpnTunnelsClientAdapter) Create(ctx context.Context, t *VPNTunnel, opts
 		return t, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return t, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return t, newHTTPError(resp, vpnTunnelFieldSetters)
 	}
 	return t, nil
 }
 
 // Get fetches a VPNTunnel by Ref and returns a freshly hydrated wrapper.
-func (a *vpnTunnelsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (*VPNTunnel, error) {
+func (a *vpnTunnelsClientAdapter) Get(ctx context.Context, ref Ref, opts ...CallOption) (_ *VPNTunnel, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNTunnelsClient.Get", "VPNTunnel", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpnTunnelID, err := vpnTunnelIDsFromRef(ref)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Get(ctx, projectID, vpnTunnelID, rp)
 	out := &VPNTunnel{}
 	populateHTTPEnvelope(&out.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		out.fromResponse(resp.Data)
+		out.setRESTClient(a.rest)
 		out.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, out)
 			if err != nil {
@@ -432,13 +473,16 @@ func (a *vpnTunnelsClientAdapter) Get(ctx context.Context, ref Ref, opts ...Call
 		return out, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return out, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return out, newHTTPError(resp, nil)
 	}
 	return out, nil
 }
 
 // Update sends a PUT for the current wrapper state. Requires ID and parent.
-func (a *vpnTunnelsClientAdapter) Update(ctx context.Context, t *VPNTunnel, opts ...CallOption) (*VPNTunnel, error) {
+func (a *vpnTunnelsClientAdapter) Update(ctx context.Context, t *VPNTunnel, opts ...CallOption) (_ *VPNTunnel, err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNTunnelsClient.Update", "VPNTunnel", t)
+	defer func() { op.end(err) }()
+
 	if err := t.Err(); err != nil {
 		return t, err
 	}
@@ -449,11 +493,13 @@ func (a *vpnTunnelsClientAdapter) Update(ctx context.Context, t *VPNTunnel, opts
 		return t, fmt.Errorf("Update: VPN tunnel has no project — call InProject first")
 	}
 	co := applyCallOptions(opts)
-	rp := co.toRequestParameters()
+	ctx = co.bind(ctx)
+	rp := co.toUpdateParameters(t.Version())
 	resp, err := a.low.Update(ctx, t.ProjectID(), t.ID(), t.toRequest(), rp)
 	populateHTTPEnvelope(&t.httpEnvelopeMixin, resp)
 	if resp != nil && resp.Data != nil {
 		t.fromResponse(resp.Data)
+		t.setRESTClient(a.rest)
 		t.setRefresh(func(ctx context.Context) error {
 			fresh, err := a.Get(ctx, t)
 			if err != nil {
@@ -469,43 +515,51 @@ func (a *vpnTunnelsClientAdapter) Update(ctx context.Context, t *VPNTunnel, opts
 		return t, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return t, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return t, newUpdateError(resp, rp, vpnTunnelFieldSetters)
 	}
 	return t, nil
 }
 
 // Delete removes the VPNTunnel identified by Ref.
-func (a *vpnTunnelsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) error {
+func (a *vpnTunnelsClientAdapter) Delete(ctx context.Context, ref Ref, opts ...CallOption) (err error) {
+	ctx, op := startOperation(ctx, a.rest, "VPNTunnelsClient.Delete", "VPNTunnel", ref)
+	defer func() { op.end(err) }()
+
 	projectID, vpnTunnelID, err := vpnTunnelIDsFromRef(ref)
 	if err != nil {
 		return err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.Delete(ctx, projectID, vpnTunnelID, rp)
 	if err != nil {
 		return err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return newHTTPError(resp, nil)
 	}
 	return nil
 }
 
 // List returns a paginated list of VPNTunnel in the given parent scope.
-func (a *vpnTunnelsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (*List[*VPNTunnel], error) {
+func (a *vpnTunnelsClientAdapter) List(ctx context.Context, project Ref, opts ...CallOption) (_ *List[*VPNTunnel], err error) {
+	ctx, op := startListOperation(ctx, a.rest, "VPNTunnelsClient.List", "VPNTunnel", project)
+	defer func() { op.end(err) }()
+
 	projectID, err := projectIDFromRef(project)
 	if err != nil {
 		return nil, err
 	}
 	co := applyCallOptions(opts)
+	ctx = co.bind(ctx)
 	rp := co.toRequestParameters()
 	resp, err := a.low.List(ctx, projectID, rp)
 	if err != nil {
 		return nil, err
 	}
 	if resp != nil && !resp.IsSuccess() {
-		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: resp.RawBody, ErrResp: resp.Error}
+		return nil, newHTTPError(resp, nil)
 	}
 	var items []*VPNTunnel
 	if resp != nil && resp.Data != nil {
@@ -513,6 +567,7 @@ func (a *vpnTunnelsClientAdapter) List(ctx context.Context, project Ref, opts ..
 		for i := range resp.Data.Values {
 			v := &VPNTunnel{}
 			v.fromResponse(&resp.Data.Values[i])
+			v.setRESTClient(a.rest)
 			v.setRefresh(func(ctx context.Context) error {
 				fresh, err := a.Get(ctx, v)
 				if err != nil {
@@ -537,7 +592,7 @@ func (a *vpnTunnelsClientAdapter) List(ctx context.Context, project Ref, opts ..
 			return nil, fetchErr
 		}
 		if pageResp != nil && !pageResp.IsSuccess() {
-			return nil, &HTTPError{StatusCode: pageResp.StatusCode, Body: pageResp.RawBody, ErrResp: pageResp.Error}
+			return nil, newHTTPError(pageResp, nil)
 		}
 		var pageItems []*VPNTunnel
 		if pageResp != nil && pageResp.Data != nil {
@@ -545,6 +600,7 @@ func (a *vpnTunnelsClientAdapter) List(ctx context.Context, project Ref, opts ..
 			for i := range pageResp.Data.Values {
 				item := &VPNTunnel{}
 				item.fromResponse(&pageResp.Data.Values[i])
+				item.setRESTClient(a.rest)
 				item.setRefresh(func(ctx context.Context) error {
 					fresh, err := a.Get(ctx, item)
 					if err != nil {
@@ -0,0 +1,275 @@
+package aruba
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Arubacloud/sdk-go/internal/ports/clienttrace"
+	"github.com/Arubacloud/sdk-go/internal/ports/logger"
+	"github.com/Arubacloud/sdk-go/internal/restclient"
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+// Span attribute keys set on the spans of adapter calls and polling ticks.
+const (
+	AttributeProjectID    = attribute.Key("aruba.project.id")
+	AttributeResourceKind = attribute.Key("aruba.resource.kind")
+	AttributeResourceID   = attribute.Key("aruba.resource.id")
+	AttributeHTTPStatus   = restclient.AttributeHTTPStatusCode
+	AttributeTraceID      = restclient.AttributeTraceID
+	AttributeWaitAttempt  = attribute.Key("aruba.wait.attempt")
+	AttributeWaitState    = attribute.Key("aruba.wait.state")
+)
+
+// operation is the span of a single adapter call (e.g.
+// CloudServersClient.Create). HTTP attempts and token refreshes performed by
+// the call are traced as its children. The outcome of the call is also logged.
+type operation struct {
+	span trace.Span
+
+	ctx    context.Context
+	name   string
+	start  time.Time
+	logger logger.StructuredLogger
+
+	// subject is the resource the call acts upon, read when the call ends so
+	// that IDs assigned by the server (e.g. on Create) are reported too.
+	subject Ref
+
+	// parentOnly marks calls whose subject is the parent of the resources
+	// involved (List), so its ID is not reported as the resource ID.
+	parentOnly bool
+}
+
+// startOperation starts the span of an adapter call acting on a single
+// resource.
+func startOperation(ctx context.Context, rest *restclient.Client, name, kind string, subject Ref) (context.Context, *operation) {
+	ctx, span := rest.Tracer().Start(ctx, name, trace.WithAttributes(AttributeResourceKind.String(kind)))
+	return ctx, &operation{
+		span:    span,
+		ctx:     ctx,
+		name:    name,
+		start:   time.Now(),
+		logger:  rest.StructuredLogger(),
+		subject: subject,
+	}
+}
+
+// startListOperation starts the span of an adapter call listing the resources
+// below parent.
+func startListOperation(ctx context.Context, rest *restclient.Client, name, kind string, parent Ref) (context.Context, *operation) {
+	ctx, op := startOperation(ctx, rest, name, kind, parent)
+	op.parentOnly = true
+	return ctx, op
+}
+
+// end records the outcome of the call, logs it and ends its span.
+func (op *operation) end(err error) {
+	defer op.span.End()
+
+	op.log(err)
+
+	if !op.span.IsRecording() {
+		return
+	}
+
+	op.span.SetAttributes(refAttributes(op.subject, op.parentOnly)...)
+
+	if err == nil {
+		return
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		op.span.SetAttributes(AttributeHTTPStatus.Int(httpErr.StatusCode))
+		if httpErr.ErrResp != nil && httpErr.ErrResp.TraceID != nil {
+			op.span.SetAttributes(AttributeTraceID.String(*httpErr.ErrResp.TraceID))
+		}
+	}
+
+	op.span.RecordError(err)
+	op.span.SetStatus(codes.Error, err.Error())
+}
+
+// log emits a debug record with the duration of the call and the project
+// and resource it acted upon.
+func (op *operation) log(err error) {
+	if !op.logger.Enabled(op.ctx, logger.LevelDebug) {
+		return
+	}
+
+	fields := []logger.Field{logger.F("operation", op.name)}
+
+	projectID, resourceID := refIDs(op.subject, op.parentOnly)
+	if projectID != "" {
+		fields = append(fields, logger.F(logger.KeyProject, projectID))
+	}
+	if resourceID != "" {
+		fields = append(fields, logger.F(logger.KeyResourceID, resourceID))
+	}
+
+	fields = append(fields, logger.F(logger.KeyDuration, time.Since(op.start)))
+
+	if err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			fields = append(fields, logger.F(logger.KeyStatus, httpErr.StatusCode))
+		}
+		fields = append(fields, logger.F(logger.KeyError, err))
+	}
+
+	op.logger.Log(op.ctx, logger.LevelDebug, "API call completed", fields...)
+}
+
+// endAction ends the span of an action dispatched by a wrapper. Actions
+// return the raw response, so an unsuccessful status is reported as the
+// HTTPError the wrapper is going to return.
+func endAction[T any](op *operation, resp *types.Response[T], err error) {
+	if err == nil && resp != nil && !resp.IsSuccess() {
+		err = newHTTPError(resp, nil)
+	}
+
+	op.end(err)
+}
+
+// refAttributes returns the span attributes of the project and resource IDs
+// of a Ref.
+func refAttributes(ref Ref, parentOnly bool) []attribute.KeyValue {
+	projectID, resourceID := refIDs(ref, parentOnly)
+
+	var attrs []attribute.KeyValue
+	if projectID != "" {
+		attrs = append(attrs, AttributeProjectID.String(projectID))
+	}
+	if resourceID != "" {
+		attrs = append(attrs, AttributeResourceID.String(resourceID))
+	}
+
+	return attrs
+}
+
+// refIDs returns the project and resource IDs of a Ref, preferring typed
+// accessors over URI parsing. The resource ID is omitted for parentOnly refs.
+func refIDs(ref Ref, parentOnly bool) (projectID, resourceID string) {
+	if ref == nil {
+		return "", ""
+	}
+
+	if v := reflect.ValueOf(ref); v.Kind() == reflect.Pointer && v.IsNil() {
+		return "", ""
+	}
+
+	projectID, _ = extractID(ref, func(r Ref) (string, bool) {
+		if w, ok := r.(withProjectID); ok {
+			return w.ProjectID(), true
+		}
+		return "", false
+	}, "projects")
+
+	if parentOnly {
+		return projectID, ""
+	}
+
+	resourceID = ref.ID()
+	if resourceID == "" {
+		resourceID = lastURIID(ref.URI())
+	}
+
+	return projectID, resourceID
+}
+
+// lastURIID returns the ID of the innermost resource-type/id pair of a URI,
+// or "" when the URI ends with a collection.
+func lastURIID(uri string) string {
+	parts := strings.Split(strings.TrimPrefix(uri, "/"), "/")
+	id := ""
+	for i := 0; i < len(parts); i++ {
+		if parts[i] == "" || namespaceSegments[parts[i]] {
+			continue
+		}
+		if i+1 >= len(parts) || parts[i+1] == "" {
+			return ""
+		}
+		id = parts[i+1]
+		i++
+	}
+	return id
+}
+
+// idRef is a Ref known only by its project and resource IDs. It lets the
+// actions dispatched by wrappers (e.g. PowerOn) be traced like adapter calls.
+type idRef struct {
+	projectID string
+	id        string
+}
+
+func (r idRef) URI() string       { return "" }
+func (r idRef) ID() string        { return r.id }
+func (r idRef) ProjectID() string { return r.projectID }
+
+// tick is a single polling tick of a wait helper, traced in a span and
+// reported to the WaitTick hook.
+type tick struct {
+	span   trace.Span
+	ctx    context.Context
+	name   string
+	trace  *clienttrace.ClientTrace
+	logger logger.StructuredLogger
+	info   clienttrace.WaitTickInfo
+}
+
+// startTick starts a polling tick. The REST client provides the tracer, the
+// hooks and the logger; a nil client disables them all.
+func startTick(ctx context.Context, rest *restclient.Client, name string, attempt int, targets []types.State) (context.Context, *tick) {
+	ctx, span := rest.Tracer().Start(ctx, name, trace.WithAttributes(AttributeWaitAttempt.Int(attempt)))
+	return ctx, &tick{
+		span:   span,
+		ctx:    ctx,
+		name:   name,
+		trace:  rest.ClientTrace(),
+		logger: rest.StructuredLogger(),
+		info:   clienttrace.WaitTickInfo{Attempt: attempt, Targets: targets},
+	}
+}
+
+// end records the outcome of the tick, logs it, ends its span and calls the
+// hook.
+func (t *tick) end(state types.State, gone bool, err error) {
+	defer t.span.End()
+
+	fields := []logger.Field{logger.F("wait", t.name), logger.F(logger.KeyAttempt, t.info.Attempt)}
+	if state != "" {
+		fields = append(fields, logger.F("state", state))
+	}
+	if gone {
+		fields = append(fields, logger.F("gone", true))
+	}
+	if err != nil {
+		t.logger.Log(t.ctx, logger.LevelWarn, "Wait tick failed", append(fields, logger.F(logger.KeyError, err))...)
+	} else {
+		t.logger.Log(t.ctx, logger.LevelDebug, "Wait tick", fields...)
+	}
+
+	if state != "" {
+		t.span.SetAttributes(AttributeWaitState.String(string(state)))
+	}
+
+	if err != nil {
+		t.span.RecordError(err)
+		t.span.SetStatus(codes.Error, err.Error())
+	}
+
+	if hook := t.trace.WaitTick; hook != nil {
+		t.info.State = state
+		t.info.Gone = gone
+		t.info.Err = err
+		hook(t.ctx, t.info)
+	}
+}
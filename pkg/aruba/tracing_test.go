@@ -0,0 +1,228 @@
+package aruba
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Arubacloud/sdk-go/internal/restclient"
+	"github.com/Arubacloud/sdk-go/pkg/types"
+)
+
+func newRecordingTracerProvider() (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)), recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestTracing_AdapterCall(t *testing.T) {
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"title":"Not Found","status":404,"traceId":"srv-42"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider, recorder := newRecordingTracerProvider()
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithTracerProvider(provider))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = cli.FromCompute().CloudServers().Get(context.Background(), URI("/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"))
+	if err == nil {
+		t.Fatal("Get: expected an error for a 404 response")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want the HTTP attempt and the adapter call", len(spans))
+	}
+	attempt, call := spans[0], spans[1]
+
+	if call.Name() != "CloudServersClient.Get" {
+		t.Errorf("call span name = %q, want %q", call.Name(), "CloudServersClient.Get")
+	}
+	if attempt.Parent().SpanID() != call.SpanContext().SpanID() {
+		t.Error("HTTP attempt span should be a child of the adapter call span")
+	}
+	if call.Status().Code != codes.Error {
+		t.Errorf("call span status = %v, want Error", call.Status().Code)
+	}
+
+	attrs := spanAttributes(call)
+	want := map[attribute.Key]string{
+		AttributeResourceKind: "CloudServer",
+		AttributeProjectID:    "p-1",
+		AttributeResourceID:   "cs-1",
+		AttributeTraceID:      "srv-42",
+	}
+	for key, value := range want {
+		if got := attrs[key].AsString(); got != value {
+			t.Errorf("attribute %s = %q, want %q", key, got, value)
+		}
+	}
+	if got := attrs[AttributeHTTPStatus].AsInt64(); got != http.StatusNotFound {
+		t.Errorf("attribute %s = %d, want %d", AttributeHTTPStatus, got, http.StatusNotFound)
+	}
+
+	if traceparent == "" || traceparent[3:35] != call.SpanContext().TraceID().String() {
+		t.Errorf("traceparent = %q, want trace ID %s", traceparent, call.SpanContext().TraceID())
+	}
+}
+
+func TestTracing_CloudServerAction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"title":"Conflict","status":409}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider, recorder := newRecordingTracerProvider()
+	cli, err := NewClient(NewOptions().
+		WithBaseURL(srv.URL).
+		WithToken("test-token").
+		WithTracerProvider(provider))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	cs := &CloudServer{}
+	cs.fromResponse(cloudServerTestResponse("cs-1", "my-server", "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"))
+	cs.projectID = "p-1"
+	cs.actions = cli.FromCompute().CloudServers().(*cloudServersClientAdapter)
+
+	if err := cs.PowerOn(context.Background()); err == nil {
+		t.Fatal("PowerOn: expected an error for a 409 response")
+	}
+
+	spans := recorder.Ended()
+	call := spans[len(spans)-1]
+	if call.Name() != "CloudServer.PowerOn" {
+		t.Fatalf("call span name = %q, want %q", call.Name(), "CloudServer.PowerOn")
+	}
+	attrs := spanAttributes(call)
+	if attrs[AttributeResourceID].AsString() != "cs-1" || attrs[AttributeProjectID].AsString() != "p-1" {
+		t.Errorf("call span attributes = %v, want the server and project IDs", attrs)
+	}
+	if got := attrs[AttributeHTTPStatus].AsInt64(); got != http.StatusConflict {
+		t.Errorf("attribute %s = %d, want %d", AttributeHTTPStatus, got, http.StatusConflict)
+	}
+}
+
+func TestTracing_WaitTicks(t *testing.T) {
+	provider, recorder := newRecordingTracerProvider()
+
+	var m statusMixin
+	var observed []types.State
+	m.setRESTClient(restclient.NewClient("", http.DefaultClient, nil, nil,
+		restclient.WithTracerProvider(provider),
+		restclient.WithClientTrace(&ClientTrace{
+			WaitTick: func(_ context.Context, info WaitTickInfo) { observed = append(observed, info.State) },
+		}),
+	))
+	calls := 0
+	m.setRefresh(func(_ context.Context) error {
+		calls++
+		state := types.StateCreating
+		if calls >= 2 {
+			state = types.StateActive
+		}
+		m.setStatus(&types.ResourceStatusResponse{State: &state})
+		return nil
+	})
+
+	if err := m.WaitUntilActive(context.Background(), WithBaseDelay(time.Millisecond)); err != nil {
+		t.Fatalf("WaitUntilActive: %v", err)
+	}
+
+	if want := []types.State{types.StateCreating, types.StateActive}; !reflect.DeepEqual(observed, want) {
+		t.Errorf("WaitTick states = %v, want %v", observed, want)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want one per tick", len(spans))
+	}
+	for i, state := range []types.State{types.StateCreating, types.StateActive} {
+		attrs := spanAttributes(spans[i])
+		if spans[i].Name() != "WaitUntilStates" {
+			t.Errorf("tick %d span name = %q, want %q", i+1, spans[i].Name(), "WaitUntilStates")
+		}
+		if got := attrs[AttributeWaitAttempt].AsInt64(); got != int64(i+1) {
+			t.Errorf("tick %d attempt = %d", i+1, got)
+		}
+		if got := attrs[AttributeWaitState].AsString(); got != string(state) {
+			t.Errorf("tick %d state = %q, want %q", i+1, got, state)
+		}
+	}
+}
+
+func TestClientTrace_WaitTicks(t *testing.T) {
+	var ticks []WaitTickInfo
+	rest := restclient.NewClient("", http.DefaultClient, nil, nil, restclient.WithClientTrace(&ClientTrace{
+		WaitTick: func(_ context.Context, info WaitTickInfo) { ticks = append(ticks, info) },
+	}))
+
+	var m refreshMixin
+	m.setRESTClient(rest)
+	m.setRefresh(func(_ context.Context) error {
+		if len(ticks) == 0 {
+			return nil
+		}
+		return &HTTPError{StatusCode: http.StatusNotFound}
+	})
+
+	if err := m.WaitUntilGone(context.Background(), WithBaseDelay(time.Millisecond)); err != nil {
+		t.Fatalf("WaitUntilGone: %v", err)
+	}
+
+	if len(ticks) != 2 {
+		t.Fatalf("WaitTick calls = %d, want 2", len(ticks))
+	}
+	if ticks[0].Attempt != 1 || ticks[0].Gone {
+		t.Errorf("first tick = %+v, want attempt 1 with the resource still there", ticks[0])
+	}
+	if ticks[1].Attempt != 2 || !ticks[1].Gone || ticks[1].Err != nil {
+		t.Errorf("second tick = %+v, want attempt 2 observing the deletion", ticks[1])
+	}
+}
+
+func TestLastURIID(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{uri: "/projects/p/providers/Aruba.Network/vpcs/v", want: "v"},
+		{uri: "/projects/p/network/vpcs/v", want: "v"},
+		{uri: "/projects/p/providers/Aruba.Compute/cloudServers", want: ""},
+		{uri: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := lastURIID(tt.uri); got != tt.want {
+			t.Errorf("lastURIID(%q) = %q, want %q", tt.uri, got, tt.want)
+		}
+	}
+}
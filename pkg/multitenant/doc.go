@@ -17,6 +17,12 @@
 //   - [NewWithTemplate] — every New("tenant") call deep-copies the template
 //     Options; slices are deep-copied, *http.Client / logger / middleware are
 //     shallow-copied as shared singletons.
+//   - [NewWithRateLimiter] — like NewWithTemplate (the template may be nil),
+//     but every client created via New or NewFromOptions is given the same
+//     [aruba.RateLimiter], so all tenants share a single request budget:
+//
+//	limiter := aruba.NewRateLimiter(20, 20, 5, 5, 10)
+//	mt := multitenant.NewWithRateLimiter(template, limiter)
 //
 // # Access methods
 //
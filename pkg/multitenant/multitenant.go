@@ -11,12 +11,15 @@ import (
 
 type Multitenant interface {
 	// New creates and stores a tenant client using the internal template options.
-	// Returns an error if the template is missing or client creation fails.
+	// Returns an error if the template is missing or client creation fails;
+	// otherwise the previous client of the tenant, if any, is closed.
 	New(tenant string) error
 	// NewFromOptions creates and stores a tenant client using the provided options.
-	// Returns an error if client creation fails.
+	// Returns an error if client creation fails; otherwise it replaces the
+	// client of the tenant as New does.
 	NewFromOptions(tenant string, options *aruba.Options) error
-	// Add stores an already initialized tenant client.
+	// Add stores an already initialized tenant client, closing the one it
+	// replaces.
 	Add(tenant string, client aruba.Client)
 	// Get returns the tenant client and true if found, otherwise nil and false.
 	Get(tenant string) (aruba.Client, bool)
@@ -24,7 +27,8 @@ type Multitenant interface {
 	MustGet(tenant string) aruba.Client
 	// GetOrNil returns the tenant client if found, otherwise nil.
 	GetOrNil(tenant string) aruba.Client
-	// CleanUp removes clients not used in the provided duration window.
+	// CleanUp removes clients not used in the provided duration window,
+	// closing them.
 	CleanUp(from time.Duration)
 }
 
@@ -38,6 +42,10 @@ type multitenant struct {
 	clients  map[string]*entry
 	template *aruba.Options
 
+	// rateLimiter, when set, is injected into every client created by New and
+	// NewFromOptions, so all tenants draw from the same budget.
+	rateLimiter aruba.RateLimiter
+
 	lock sync.RWMutex
 }
 
@@ -59,6 +67,29 @@ func NewWithTemplate(template *aruba.Options) Multitenant {
 	}
 }
 
+// NewWithRateLimiter creates an empty multitenant client manager whose tenant
+// clients all share the given rate limiter. The template is optional and is
+// used by New to instantiate tenant clients.
+// Clients registered via Add are not affected.
+func NewWithRateLimiter(template *aruba.Options, limiter aruba.RateLimiter) Multitenant {
+	return &multitenant{
+		clients:     make(map[string]*entry),
+		template:    template,
+		rateLimiter: limiter,
+	}
+}
+
+// withSharedDependencies returns the options to be used for a new tenant
+// client, injecting the shared rate limiter if any. The given options are
+// never modified.
+func (m *multitenant) withSharedDependencies(options *aruba.Options) *aruba.Options {
+	if m.rateLimiter == nil {
+		return options
+	}
+
+	return options.DeepCopy().WithCustomRateLimiter(m.rateLimiter)
+}
+
 func (m *multitenant) New(tenant string) error {
 	if m.template == nil {
 		return errors.New("template is missing - use the `NewFromOptions` method")
@@ -67,14 +98,14 @@ func (m *multitenant) New(tenant string) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	template := m.template.DeepCopy()
+	template := m.withSharedDependencies(m.template.DeepCopy())
 
 	c, err := aruba.NewClient(template)
 	if err != nil {
 		return err
 	}
 
-	m.clients[tenant] = &entry{client: c, lastUsage: time.Now()}
+	m.store(tenant, c)
 
 	return nil
 }
@@ -83,12 +114,12 @@ func (m *multitenant) NewFromOptions(tenant string, options *aruba.Options) erro
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	c, err := aruba.NewClient(options)
+	c, err := aruba.NewClient(m.withSharedDependencies(options))
 	if err != nil {
 		return err
 	}
 
-	m.clients[tenant] = &entry{client: c, lastUsage: time.Now()}
+	m.store(tenant, c)
 
 	return nil
 }
@@ -97,6 +128,16 @@ func (m *multitenant) Add(tenant string, client aruba.Client) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
+	m.store(tenant, client)
+}
+
+// store saves the client of the tenant, closing the one it replaces, if any.
+// The caller must hold the write lock.
+func (m *multitenant) store(tenant string, client aruba.Client) {
+	if e, ok := m.clients[tenant]; ok && e != nil && e.client != nil && e.client != client {
+		_ = e.client.Close()
+	}
+
 	m.clients[tenant] = &entry{client: client, lastUsage: time.Now()}
 }
 
@@ -172,6 +213,7 @@ func (m *multitenant) CleanUp(from time.Duration) {
 			continue
 		}
 		if e.lastUsage.Before(cleanupTime) {
+			_ = e.client.Close()
 			delete(m.clients, t)
 		}
 	}
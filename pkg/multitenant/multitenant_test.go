@@ -0,0 +1,110 @@
+package multitenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/pkg/aruba"
+)
+
+// countingLimiter counts the requests admitted across every client sharing it.
+type countingLimiter struct {
+	acquired atomic.Int32
+}
+
+func (l *countingLimiter) Acquire(ctx context.Context, method string) (func(), error) {
+	l.acquired.Add(1)
+	return func() {}, nil
+}
+
+func TestNewWithRateLimiter_SharesLimiterAcrossTenants(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total":0,"values":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	limiter := &countingLimiter{}
+	template := aruba.NewOptions().WithBaseURL(srv.URL).WithToken("template-token")
+	mt := NewWithRateLimiter(template, limiter)
+
+	if err := mt.New("tenant-a"); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := mt.NewFromOptions("tenant-b", aruba.NewOptions().WithBaseURL(srv.URL).WithToken("tenant-b-token")); err != nil {
+		t.Fatalf("NewFromOptions: %v", err)
+	}
+
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		if _, err := mt.MustGet(tenant).FromProject().List(context.Background()); err != nil {
+			t.Fatalf("%s List: %v", tenant, err)
+		}
+	}
+
+	if got := limiter.acquired.Load(); got != 2 {
+		t.Errorf("shared limiter admitted %d requests, want 2", got)
+	}
+}
+
+func TestNewWithRateLimiter_NilTemplate(t *testing.T) {
+	mt := NewWithRateLimiter(nil, &countingLimiter{})
+
+	if err := mt.New("tenant"); err == nil {
+		t.Error("New should fail without a template")
+	}
+}
+
+// closingClient records whether it has been closed.
+type closingClient struct {
+	aruba.Client
+	closed atomic.Bool
+}
+
+func (c *closingClient) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func TestMultitenant_ClosesReplacedClients(t *testing.T) {
+	mt := NewWithTemplate(aruba.NewOptions().WithBaseURL("http://localhost:8080").WithToken("template-token"))
+
+	first, second, third := &closingClient{}, &closingClient{}, &closingClient{}
+	mt.Add("tenant", first)
+
+	// Storing the same client again keeps it open.
+	mt.Add("tenant", first)
+	if first.closed.Load() {
+		t.Error("client closed when stored again")
+	}
+
+	mt.Add("tenant", second)
+	if !first.closed.Load() {
+		t.Error("client replaced by Add not closed")
+	}
+
+	if err := mt.New("tenant"); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if !second.closed.Load() {
+		t.Error("client replaced by New not closed")
+	}
+
+	mt.Add("tenant", third)
+	if err := mt.NewFromOptions("tenant", aruba.NewOptions().WithBaseURL("http://localhost:8080").WithToken("tenant-token")); err != nil {
+		t.Fatalf("NewFromOptions: %v", err)
+	}
+	if !third.closed.Load() {
+		t.Error("client replaced by NewFromOptions not closed")
+	}
+
+	// Other tenants are not affected.
+	other := &closingClient{}
+	mt.Add("other", other)
+	mt.Add("tenant", first)
+	if other.closed.Load() {
+		t.Error("client of another tenant closed")
+	}
+}
@@ -0,0 +1,115 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// cassetteVersion is the version of the cassette file format.
+const cassetteVersion = 1
+
+// Cassette is the content of a cassette file: the recorded interactions, in
+// the order they happened.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and the response it got.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a scrubbed request. The host is not recorded.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a scrubbed response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// loadCassette reads a cassette file.
+func loadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCassette, err)
+	}
+
+	if cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidCassette, cassette.Version)
+	}
+
+	return &cassette, nil
+}
+
+// save writes the cassette file, creating its directory if needed.
+func (c *Cassette) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// matches reports whether the recorded request matches a request with the
+// given method, path, query and scrubbed body.
+func (r *RecordedRequest) matches(method, path string, query url.Values, body []byte) bool {
+	if r.Method != method || r.Path != path {
+		return false
+	}
+
+	recordedQuery, err := url.ParseQuery(r.Query)
+	if err != nil || recordedQuery.Encode() != query.Encode() {
+		return false
+	}
+
+	return bytes.Equal(normalizeBody([]byte(r.Body)), normalizeBody(body))
+}
+
+// normalizeBody returns a canonical form of a body: JSON documents are
+// re-encoded compactly with sorted keys, other bodies are left untouched.
+func normalizeBody(body []byte) []byte {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil || decoder.More() {
+		return body
+	}
+
+	normalized, err := json.Marshal(document)
+	if err != nil {
+		return body
+	}
+
+	return normalized
+}
@@ -0,0 +1,48 @@
+// Package recorder provides an HTTP transport recording the exchanges made by
+// an aruba.Client into cassette files and replaying them offline, so that
+// integration-style tests of orchestration code run deterministically in CI
+// without network access.
+//
+// # Overview
+//
+// Plug a Recorder into the client through a custom HTTP client:
+//
+//	rec, err := recorder.New("testdata/create-vpc.json", recorder.ModeReplayOrRecord)
+//	if err != nil { ... }
+//	defer rec.Stop()
+//
+//	client, err := aruba.NewClient(aruba.DefaultOptions(clientID, clientSecret).
+//		WithCustomHTTPClient(rec.HTTPClient()))
+//
+// The token exchange with the token issuer goes through the Recorder too. The
+// first run (with credentials and network access) records the cassette; later
+// runs replay it without contacting the API or the token issuer.
+//
+// # Modes
+//
+//   - [ModeReplay] — never contacts the network; a request without a
+//     matching interaction fails with [ErrNoInteraction].
+//   - [ModeRecord] — sends every request and records a new cassette,
+//     replacing the previous one on Stop.
+//   - [ModeReplayOrRecord] — replays the cassette when the file exists and
+//     records it otherwise.
+//
+// # Matching
+//
+// Requests are matched on method, path, query and normalized body: query
+// parameters are compared regardless of their order and JSON bodies
+// regardless of formatting and key order. The host is ignored, so cassettes
+// recorded against the API can be replayed against any base URL. Identical
+// requests (e.g. polling a resource until it is active) are replayed in the
+// order they were recorded.
+//
+// # Scrubbing
+//
+// The Authorization header, the other credentials headers and the fields the
+// SDK knows to be sensitive (passwords, client secrets, pre-shared keys,
+// kubeconfigs, KMIP certificates, the issued tokens and the credentials of
+// form-encoded token requests) are masked before the cassette is written.
+// [WithScrubbedJSONPaths] and [WithScrubbedHeaders] mask additional data.
+// Request bodies are scrubbed before matching too, so replays match the
+// recorded requests even though their secrets differ.
+package recorder
@@ -0,0 +1,324 @@
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	std_redactor "github.com/Arubacloud/sdk-go/internal/impl/redact/standard"
+	"github.com/Arubacloud/sdk-go/internal/ports/redact"
+)
+
+var (
+	ErrNoInteraction   = errors.New("recorder: no matching interaction in cassette")
+	ErrInvalidCassette = errors.New("recorder: invalid cassette")
+	ErrStopped         = errors.New("recorder: stopped")
+)
+
+// Mode selects whether a Recorder replays or records interactions.
+type Mode int
+
+const (
+	// ModeReplay replays the cassette and never contacts the network.
+	ModeReplay Mode = iota
+	// ModeRecord sends every request and records a new cassette.
+	ModeRecord
+	// ModeReplayOrRecord replays the cassette if the file exists and records
+	// it otherwise.
+	ModeReplayOrRecord
+)
+
+// Recorder is an http.RoundTripper recording or replaying the exchanges of a
+// cassette. It is safe for concurrent use, although concurrent identical
+// requests are replayed in an unspecified order.
+type Recorder struct {
+	path      string
+	recording bool
+	transport http.RoundTripper
+
+	jsonPaths []string
+	headers   []string
+	redactor  *std_redactor.Redactor
+
+	locker   sync.Mutex
+	cassette *Cassette
+	// used marks the interactions already replayed.
+	used    []bool
+	stopped bool
+}
+
+var _ http.RoundTripper = (*Recorder)(nil)
+
+// Option configures optional behaviours of the Recorder.
+type Option func(*Recorder)
+
+// WithTransport sets the transport sending the requests being recorded.
+// Defaults to http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithScrubbedJSONPaths masks the values found at the given dot-separated
+// JSON paths in every recorded body, on top of the fields the SDK knows to be
+// sensitive. Arrays are traversed transparently and a "*" segment matches
+// any key.
+func WithScrubbedJSONPaths(paths ...string) Option {
+	return func(r *Recorder) {
+		r.jsonPaths = append(r.jsonPaths, paths...)
+	}
+}
+
+// WithScrubbedHeaders masks the values of the given headers in every
+// recorded request and response, on top of Authorization,
+// Proxy-Authorization, Cookie and Set-Cookie.
+func WithScrubbedHeaders(headers ...string) Option {
+	return func(r *Recorder) {
+		r.headers = append(r.headers, headers...)
+	}
+}
+
+// New creates a Recorder for the cassette file at path. In ModeReplay the
+// cassette must exist; in ModeRecord it is written on Stop.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		transport: http.DefaultTransport,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	rules := append(std_redactor.DefaultRules(), tokenResponseRule)
+	if len(r.jsonPaths) > 0 {
+		rules = append(rules, std_redactor.Rule{Fields: r.jsonPaths})
+	}
+	r.redactor = std_redactor.NewRedactor(rules, append(std_redactor.DefaultHeaders(), r.headers...))
+
+	switch mode {
+	case ModeRecord:
+		r.recording = true
+
+	case ModeReplay, ModeReplayOrRecord:
+		cassette, err := loadCassette(path)
+		if errors.Is(err, fs.ErrNotExist) && mode == ModeReplayOrRecord {
+			r.recording = true
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load cassette %s: %w", path, err)
+		}
+
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+
+	default:
+		return nil, fmt.Errorf("unsupported recorder mode: %d", mode)
+	}
+
+	if r.recording {
+		r.cassette = &Cassette{Version: cassetteVersion}
+	}
+
+	return r, nil
+}
+
+// Recording reports whether the Recorder records interactions, as opposed to
+// replaying them.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// HTTPClient returns an *http.Client sending its requests through the
+// Recorder, to be passed to aruba.Options.WithCustomHTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop ends the session. When recording, it writes the cassette file.
+// Requests sent afterwards fail with ErrStopped.
+func (r *Recorder) Stop() error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	if r.stopped {
+		return nil
+	}
+	r.stopped = true
+
+	if !r.recording {
+		return nil
+	}
+
+	if err := r.cassette.save(r.path); err != nil {
+		return fmt.Errorf("failed to save cassette %s: %w", r.path, err)
+	}
+
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readAndRestore(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   req.URL.Query().Encode(),
+		Headers: r.redactor.RedactHeaders(req.Header),
+		Body:    string(r.redactor.RedactBody(req.URL.Path, scrubForm(req.Header, body))),
+	}
+
+	if r.recording {
+		return r.record(req, recorded)
+	}
+
+	return r.replay(req, recorded)
+}
+
+// record sends the request and appends the scrubbed interaction to the
+// cassette. The caller gets the actual response.
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	if r.isStopped() {
+		return nil, ErrStopped
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := readAndRestore(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    r.redactor.RedactHeaders(resp.Header),
+			Body:       string(r.redactor.RedactBody(req.URL.Path, body)),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching the
+// request.
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	if r.stopped {
+		return nil, ErrStopped
+	}
+
+	query := req.URL.Query()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !interaction.Request.matches(req.Method, req.URL.Path, query, []byte(recorded.Body)) {
+			continue
+		}
+
+		r.used[i] = true
+
+		// The body may have been scrubbed, so its recorded length is stale.
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		header.Del("Content-Length")
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+}
+
+// tokenResponseRule masks the tokens issued by the OAuth2 token endpoint,
+// which the client reaches through the Recorder as well.
+var tokenResponseRule = std_redactor.Rule{
+	Path:   regexp.MustCompile(`/token$`),
+	Fields: []string{"access_token", "refresh_token", "id_token"},
+}
+
+// sensitiveFormFields are the parameters of OAuth2 requests carrying
+// credentials.
+var sensitiveFormFields = []string{
+	"client_secret", "client_assertion", "refresh_token", "code", "code_verifier", "device_code", "password",
+}
+
+// scrubForm masks the credentials of a form-encoded body, such as an OAuth2
+// token request. Other bodies are returned untouched.
+func scrubForm(header http.Header, body []byte) []byte {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if mediaType != "application/x-www-form-urlencoded" {
+		return body
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return body
+	}
+
+	for _, field := range sensitiveFormFields {
+		if form.Has(field) {
+			form.Set(field, redact.Mask)
+		}
+	}
+
+	return []byte(form.Encode())
+}
+
+func (r *Recorder) isStopped() bool {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	return r.stopped
+}
+
+// readAndRestore reads a body and replaces it with a reader over the same
+// bytes, so it can still be consumed.
+func readAndRestore(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
@@ -0,0 +1,228 @@
+package recorder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Arubacloud/sdk-go/pkg/aruba"
+)
+
+const testCloudServerURI = "/projects/p-1/providers/Aruba.Compute/cloudServers/cs-1"
+
+func newTestClient(t *testing.T, baseURL string, rec *Recorder) aruba.Client {
+	t.Helper()
+	cli, err := aruba.NewClient(aruba.NewOptions().
+		WithBaseURL(baseURL).
+		WithToken("super-secret-token").
+		WithCustomHTTPClient(rec.HTTPClient()))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return cli
+}
+
+func TestRecorder_RecordThenReplay(t *testing.T) {
+	states := []string{"Creating", "Active", "Active"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == testCloudServerURI:
+			state := states[0]
+			states = states[1:]
+			_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + testCloudServerURI + `"},"status":{"state":"` + state + `"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cassette := filepath.Join(t.TempDir(), "cassettes", "cloud-server.json")
+
+	run := func(baseURL string, mode Mode) []aruba.State {
+		t.Helper()
+		rec, err := New(cassette, mode)
+		if err != nil {
+			t.Fatalf("New: %v", err)
+		}
+
+		cli := newTestClient(t, baseURL, rec)
+		var observed []aruba.State
+		for range 2 {
+			cs, err := cli.FromCompute().CloudServers().Get(context.Background(), aruba.URI(testCloudServerURI))
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			observed = append(observed, cs.State())
+		}
+
+		cs, err := cli.FromCompute().CloudServers().Get(context.Background(), aruba.URI(testCloudServerURI))
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if err := cs.SetPassword(context.Background(), "hunter2"); err != nil {
+			t.Fatalf("SetPassword: %v", err)
+		}
+
+		if err := rec.Stop(); err != nil {
+			t.Fatalf("Stop: %v", err)
+		}
+		return observed
+	}
+
+	recorded := run(srv.URL, ModeReplayOrRecord)
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	for _, secret := range []string{"super-secret-token", "hunter2"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	// The server is gone: the replay must not touch the network.
+	srv.Close()
+	replayed := run("http://replay.invalid", ModeReplayOrRecord)
+
+	if len(replayed) != 2 || replayed[0] != recorded[0] || replayed[1] != recorded[1] {
+		t.Errorf("replayed states = %v, want the recorded %v", replayed, recorded)
+	}
+	if recorded[0] != "Creating" || recorded[1] != "Active" {
+		t.Errorf("recorded states = %v, want [Creating Active]", recorded)
+	}
+}
+
+func TestRecorder_ReplayWithClientCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			_, _ = w.Write([]byte(`{"access_token":"issued-token","refresh_token":"refresh-token","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer issued-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"metadata":{"id":"cs-1","name":"web","uri":"` + testCloudServerURI + `"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	run := func(baseURL string, mode Mode) {
+		t.Helper()
+		rec, err := New(cassette, mode)
+		if err != nil {
+			t.Fatalf("New: %v", err)
+		}
+
+		cli, err := aruba.NewClient(aruba.NewOptions().
+			WithBaseURL(baseURL).
+			WithTokenIssuerURL(baseURL+"/token").
+			WithClientCredentials("client-id", "client-secret").
+			WithCustomHTTPClient(rec.HTTPClient()).
+			WithNoLogs())
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		cs, err := cli.FromCompute().CloudServers().Get(context.Background(), aruba.URI(testCloudServerURI))
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if cs.Name() != "web" {
+			t.Errorf("Name() = %q, want %q", cs.Name(), "web")
+		}
+
+		if err := rec.Stop(); err != nil {
+			t.Fatalf("Stop: %v", err)
+		}
+	}
+
+	run(srv.URL, ModeRecord)
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	for _, secret := range []string{"client-secret", "issued-token", "refresh-token"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	// The token exchange is replayed too, without any network access.
+	srv.Close()
+	run("http://replay.invalid", ModeReplay)
+}
+
+func TestRecorder_ReplayMismatch(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	c := &Cassette{Version: cassetteVersion, Interactions: []Interaction{{
+		Request:  RecordedRequest{Method: http.MethodPost, Path: "/things", Query: "b=2&a=1", Body: `{"name":"x","size":1}`},
+		Response: RecordedResponse{StatusCode: http.StatusCreated, Body: `{"ok":true}`},
+	}}}
+	if err := c.save(cassette); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	rec, err := New(cassette, ModeReplay)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	client := rec.HTTPClient()
+
+	post := func(query, body string) (*http.Response, error) {
+		return client.Post("http://any.host/things?"+query, "application/json", strings.NewReader(body))
+	}
+
+	if _, err := post("a=1&b=2", `{"name":"y","size":1}`); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("different body error = %v, want ErrNoInteraction", err)
+	}
+
+	// Query order and JSON formatting are normalized.
+	resp, err := post("a=1&b=2", "{\n  \"size\": 1,\n  \"name\": \"x\"\n}")
+	if err != nil {
+		t.Fatalf("matching request error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	// Every interaction is replayed once.
+	if _, err := post("a=1&b=2", `{"name":"x","size":1}`); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("exhausted interaction error = %v, want ErrNoInteraction", err)
+	}
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	if err == nil {
+		t.Fatal("New: expected an error for a missing cassette in replay mode")
+	}
+}
+
+func TestScrubForm(t *testing.T) {
+	form := http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}}
+	body := []byte("grant_type=client_credentials&client_id=id&client_secret=secret&client_assertion=jwt")
+
+	got := string(scrubForm(form, body))
+	if strings.Contains(got, "secret=secret") || strings.Contains(got, "jwt") || !strings.Contains(got, "client_id=id") {
+		t.Errorf("scrubForm() = %q, want the credentials masked only", got)
+	}
+
+	json := http.Header{"Content-Type": {"application/json"}}
+	if got := string(scrubForm(json, []byte(`{"client_secret":"secret"}`))); got != `{"client_secret":"secret"}` {
+		t.Errorf("scrubForm() = %q, want a JSON body untouched", got)
+	}
+}
@@ -1,6 +1,17 @@
 package types
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Sentinel errors wrapped by the service clients when a response cannot be
+// decoded, so callers can tell a malformed response from an API error with
+// errors.Is.
+var (
+	ErrReadResponse  = errors.New("failed to read response body")
+	ErrParseResponse = errors.New("failed to parse response")
+)
 
 // ValidationError is one field-level validation error (e.g. 400 payloads with an "errors" array).
 type ValidationError struct {
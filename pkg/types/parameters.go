@@ -1,6 +1,9 @@
 package types
 
-import "strconv"
+import (
+	"net/http"
+	"strconv"
+)
 
 // AcceptHeader defines model for acceptHeader.
 type AcceptHeader string
@@ -13,6 +16,18 @@ type RequestParameters struct {
 	Offset     *int32        `json:"offset,omitempty"`
 	Limit      *int32        `json:"limit,omitempty"`
 	APIVersion *string       `json:"api-version,omitempty"`
+	// IfMatch is the entity tag sent in the If-Match header, making an update
+	// conditional on the resource being unchanged on the server.
+	IfMatch *string `json:"-"`
+	// IdempotencyKey is sent in the Idempotency-Key header, so that the API
+	// applies a mutating request at most once however many times it is sent.
+	IdempotencyKey *string `json:"-"`
+	// RequestID is sent in the X-Request-ID header, to correlate the request
+	// with the logs of the caller and of the API.
+	RequestID *string `json:"-"`
+	// Headers are extra HTTP headers sent with the request. The headers
+	// modelled by the other fields take precedence.
+	Headers map[string]string `json:"-"`
 }
 
 // ToQueryParams converts RequestParameters to a map of query parameters
@@ -58,9 +73,25 @@ func (r *RequestParameters) ToHeaders() map[string]string {
 		return headers
 	}
 
+	for name, value := range r.Headers {
+		headers[http.CanonicalHeaderKey(name)] = value
+	}
+
 	if r.Accept != nil && *r.Accept != "" {
 		headers["Accept"] = string(*r.Accept)
 	}
 
+	if r.IfMatch != nil && *r.IfMatch != "" {
+		headers["If-Match"] = *r.IfMatch
+	}
+
+	if r.IdempotencyKey != nil && *r.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = *r.IdempotencyKey
+	}
+
+	if r.RequestID != nil && *r.RequestID != "" {
+		headers["X-Request-ID"] = *r.RequestID
+	}
+
 	return headers
 }
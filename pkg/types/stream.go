@@ -0,0 +1,153 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DecodeList decodes a list payload (a ListResponse with its "values" array)
+// from r, calling yield for every item as soon as it is decoded, so the page
+// is never held in memory as a whole. Decoding stops early, without error, if
+// yield returns false.
+//
+// It returns the pagination metadata of the payload. Fields following the
+// values array are only read when the whole payload has been decoded. An
+// empty r yields io.EOF.
+func DecodeList[T any](r io.Reader, yield func(T) bool) (ListResponse, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return ListResponse{}, err
+	}
+
+	page, err := decodeListFields(dec, yield)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return page, err
+}
+
+// decodeListFields decodes the fields of a list payload, after its opening
+// brace.
+func decodeListFields[T any](dec *json.Decoder, yield func(T) bool) (ListResponse, error) {
+	var page ListResponse
+	fields := map[string]any{
+		"total": &page.Total,
+		"self":  &page.Self,
+		"prev":  &page.Prev,
+		"next":  &page.Next,
+		"first": &page.First,
+		"last":  &page.Last,
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return page, err
+		}
+		key, _ := token.(string)
+
+		if key == "values" {
+			done, err := decodeValues(dec, yield)
+			if err != nil || done {
+				return page, err
+			}
+			continue
+		}
+
+		var target any = new(json.RawMessage)
+		if field, ok := fields[key]; ok {
+			target = field
+		}
+		if err := dec.Decode(target); err != nil {
+			return page, fmt.Errorf("invalid list field %q: %w", key, err)
+		}
+	}
+
+	if err := expectDelim(dec, '}'); err != nil {
+		return page, err
+	}
+
+	return page, expectEOF(dec)
+}
+
+// ParseListResponseBody is the streaming counterpart of ParseResponseBody for
+// list payloads: the items of a 2xx body are passed to yield as they are
+// decoded (see DecodeList) and Data holds the pagination metadata only.
+// RawBody is left empty for 2xx responses. Non-2xx responses are parsed as by
+// ParseResponseBody.
+func ParseListResponseBody[T any](httpResp *http.Response, logger DebugLogger, yield func(T) bool) (*Response[ListResponse], error) {
+	if httpResp == nil {
+		return nil, fmt.Errorf("http response is nil")
+	}
+
+	response := &Response[ListResponse]{
+		HTTPResponse: httpResp,
+		StatusCode:   httpResp.StatusCode,
+		Headers:      httpResp.Header,
+	}
+
+	if !response.IsSuccess() {
+		if err := parseErrorBody(response, logger); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	body := &readErrorRecorder{r: httpResp.Body}
+	page, err := DecodeList(body, yield)
+
+	switch {
+	case body.err != nil:
+		return nil, fmt.Errorf("%w: %w", ErrReadResponse, body.err)
+	case errors.Is(err, io.EOF):
+		// Empty body
+	case err != nil:
+		return nil, fmt.Errorf("%w: %w", ErrParseResponse, err)
+	default:
+		response.Data = &page
+	}
+
+	return response, nil
+}
+
+// decodeValues decodes the values array of a list payload, passing each item
+// to yield. It reports whether yield stopped the decoding.
+func decodeValues[T any](dec *json.Decoder, yield func(T) bool) (bool, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return false, err
+	}
+	if token == nil {
+		return false, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return false, fmt.Errorf("invalid list values: expected an array, got %v", token)
+	}
+
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return false, fmt.Errorf("invalid list item: %w", err)
+		}
+		if !yield(item) {
+			return true, nil
+		}
+	}
+
+	return false, expectDelim(dec, ']')
+}
+
+// expectDelim reads the next token, which must be the given delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("invalid list payload: expected %v, got %v", want, token)
+	}
+	return nil
+}
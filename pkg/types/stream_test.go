@@ -0,0 +1,108 @@
+package types
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+type streamedItem struct {
+	ID string `json:"id"`
+}
+
+func TestDecodeList(t *testing.T) {
+	t.Run("yields every item and reads the metadata around the values", func(t *testing.T) {
+		payload := `{"total":3,"self":"/p?offset=0","values":[{"id":"a"},{"id":"b"},{"id":"c"}],"next":"/p?offset=3","extra":{"ignored":true}}`
+
+		var ids []string
+		page, err := DecodeList(strings.NewReader(payload), func(item streamedItem) bool {
+			ids = append(ids, item.ID)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Join(ids, ",") != "a,b,c" {
+			t.Errorf("items = %v, want a,b,c", ids)
+		}
+		if page.Total != 3 || page.Self != "/p?offset=0" || page.Next != "/p?offset=3" {
+			t.Errorf("page = %+v", page)
+		}
+	})
+
+	t.Run("stops when yield returns false", func(t *testing.T) {
+		var ids []string
+		_, err := DecodeList(strings.NewReader(`{"values":[{"id":"a"},{"id":"b"},{"id":`), func(item streamedItem) bool {
+			ids = append(ids, item.ID)
+			return false
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(ids) != 1 {
+			t.Errorf("items = %v, want only the first one", ids)
+		}
+	})
+
+	t.Run("null values yield nothing", func(t *testing.T) {
+		page, err := DecodeList(strings.NewReader(`{"total":0,"values":null}`), func(streamedItem) bool {
+			t.Error("yield called for null values")
+			return true
+		})
+		if err != nil || page.Total != 0 {
+			t.Errorf("page = %+v, err = %v", page, err)
+		}
+	})
+
+	t.Run("empty input yields io.EOF", func(t *testing.T) {
+		if _, err := DecodeList(strings.NewReader(""), func(streamedItem) bool { return true }); !errors.Is(err, io.EOF) {
+			t.Errorf("expected io.EOF, got %v", err)
+		}
+	})
+
+	t.Run("malformed payloads fail", func(t *testing.T) {
+		for _, payload := range []string{`[]`, `{"values":{}}`, `{"values":[{"id":1}]}`, `{"values":[{"id":"a"}`} {
+			_, err := DecodeList(strings.NewReader(payload), func(streamedItem) bool { return true })
+			if err == nil || errors.Is(err, io.EOF) {
+				t.Errorf("DecodeList(%s) error = %v, want a parse error", payload, err)
+			}
+		}
+	})
+}
+
+func TestParseListResponseBody(t *testing.T) {
+	t.Run("2xx streams the items into yield", func(t *testing.T) {
+		var ids []string
+		resp, err := ParseListResponseBody(makeHTTPResponse(200, `{"total":1,"values":[{"id":"a"}]}`), &captureLogger{}, func(item streamedItem) bool {
+			ids = append(ids, item.ID)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(ids) != 1 || resp.Data == nil || resp.Data.Total != 1 {
+			t.Errorf("items = %v, Data = %+v", ids, resp.Data)
+		}
+	})
+
+	t.Run("4xx is parsed as an error response", func(t *testing.T) {
+		resp, err := ParseListResponseBody(makeHTTPResponse(404, `{"title":"Not Found","status":404}`), &captureLogger{}, func(streamedItem) bool {
+			t.Error("yield called for an error response")
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Error == nil || len(resp.RawBody) == 0 {
+			t.Errorf("Error = %v, RawBody = %q", resp.Error, resp.RawBody)
+		}
+	})
+
+	t.Run("2xx with invalid JSON wraps ErrParseResponse", func(t *testing.T) {
+		_, err := ParseListResponseBody(makeHTTPResponse(200, `{"values":[`), &captureLogger{}, func(streamedItem) bool { return true })
+		if !errors.Is(err, ErrParseResponse) {
+			t.Fatalf("expected ErrParseResponse, got %v", err)
+		}
+	})
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,7 +17,7 @@ type DebugLogger interface {
 }
 
 // ParseResponseBody reads and parses the HTTP response body into the Response struct.
-// For 2xx responses, it unmarshals into Data field.
+// For 2xx responses, it decodes the JSON body into Data as it is read from the stream.
 // For 4xx/5xx responses, it unmarshals into Error field.
 // Always stores the raw body in RawBody field.
 // When the error body cannot be parsed as JSON, a DEBUG message is emitted via logger;
@@ -25,37 +27,97 @@ func ParseResponseBody[T any](httpResp *http.Response, logger DebugLogger) (*Res
 		return nil, fmt.Errorf("http response is nil")
 	}
 
-	// Read the response body
-	bodyBytes, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
 	// Create the response wrapper
 	response := &Response[T]{
 		HTTPResponse: httpResp,
 		StatusCode:   httpResp.StatusCode,
 		Headers:      httpResp.Header,
-		RawBody:      bodyBytes,
 	}
 
-	// Parse the response body based on status code
-	if response.IsSuccess() && len(bodyBytes) > 0 {
-		var data T
-		if err := json.Unmarshal(bodyBytes, &data); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+	if !response.IsSuccess() {
+		if err := parseErrorBody(response, logger); err != nil {
+			return nil, err
 		}
+		return response, nil
+	}
+
+	// Decode the body while reading it, keeping a copy of the raw bytes
+	var raw bytes.Buffer
+	if httpResp.ContentLength > 0 {
+		raw.Grow(int(httpResp.ContentLength))
+	}
+	body := &readErrorRecorder{r: io.TeeReader(httpResp.Body, &raw)}
+
+	var data T
+	dec := json.NewDecoder(body)
+	err := dec.Decode(&data)
+	if err == nil {
+		err = expectEOF(dec)
+	}
+	if _, copyErr := io.Copy(io.Discard, body); copyErr != nil && err == nil {
+		err = copyErr
+	}
+	response.RawBody = raw.Bytes()
+
+	switch {
+	case body.err != nil:
+		return nil, fmt.Errorf("%w: %w", ErrReadResponse, body.err)
+	case errors.Is(err, io.EOF):
+		// Empty body
+	case err != nil:
+		return nil, fmt.Errorf("%w: %w", ErrParseResponse, err)
+	default:
 		response.Data = &data
-	} else if response.IsError() && len(bodyBytes) > 0 {
+	}
+
+	return response, nil
+}
+
+// parseErrorBody reads the body of a non-2xx response into RawBody and, for
+// 4xx/5xx statuses, unmarshals it into Error.
+func parseErrorBody[T any](response *Response[T], logger DebugLogger) error {
+	bodyBytes, err := io.ReadAll(response.HTTPResponse.Body)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrReadResponse, err)
+	}
+	response.RawBody = bodyBytes
+
+	if response.IsError() && len(bodyBytes) > 0 {
 		var errorResp ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &errorResp); err != nil {
-			logger.Debugf("ParseResponseBody: failed to unmarshal error body (status %d): %v; inspect RawBody for the raw response", httpResp.StatusCode, err)
+			logger.Debugf("ParseResponseBody: failed to unmarshal error body (status %d): %v; inspect RawBody for the raw response", response.StatusCode, err)
 		} else {
 			response.Error = &errorResp
 		}
 	}
 
-	return response, nil
+	return nil
+}
+
+// expectEOF fails when the decoder has more data after the JSON value.
+func expectEOF(dec *json.Decoder) error {
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		if err == nil {
+			err = errors.New("invalid data after top-level value")
+		}
+		return err
+	}
+	return nil
+}
+
+// readErrorRecorder records the failures of the underlying reader, so they
+// can be told apart from malformed JSON.
+type readErrorRecorder struct {
+	r   io.Reader
+	err error
+}
+
+func (r *readErrorRecorder) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.err = err
+	}
+	return n, err
 }
 
 // Validation helper functions
@@ -1,11 +1,13 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 // captureLogger records Debugf calls for assertion in tests.
@@ -90,6 +92,57 @@ func TestParseResponseBody(t *testing.T) {
 		}
 	})
 
+	t.Run("2xx with invalid JSON wraps ErrParseResponse", func(t *testing.T) {
+		logger := &captureLogger{}
+		_, err := ParseResponseBody[map[string]string](
+			makeHTTPResponse(200, `{"key":`),
+			logger,
+		)
+		if !errors.Is(err, ErrParseResponse) {
+			t.Fatalf("expected ErrParseResponse, got %v", err)
+		}
+		if !strings.HasPrefix(err.Error(), "failed to parse response: ") {
+			t.Errorf("unexpected error message: %s", err)
+		}
+	})
+
+	t.Run("2xx keeps the raw body of the decoded stream", func(t *testing.T) {
+		body := `{"key":"value"}` + "\n"
+		resp, err := ParseResponseBody[map[string]string](makeHTTPResponse(200, body), &captureLogger{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(resp.RawBody) != body {
+			t.Errorf("RawBody = %q, want %q", resp.RawBody, body)
+		}
+	})
+
+	t.Run("2xx with empty body leaves Data nil", func(t *testing.T) {
+		resp, err := ParseResponseBody[map[string]string](makeHTTPResponse(204, ""), &captureLogger{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Data != nil {
+			t.Errorf("expected Data to be nil for an empty body")
+		}
+	})
+
+	t.Run("2xx with trailing data wraps ErrParseResponse", func(t *testing.T) {
+		_, err := ParseResponseBody[map[string]string](makeHTTPResponse(200, `{"key":"value"} {}`), &captureLogger{})
+		if !errors.Is(err, ErrParseResponse) {
+			t.Fatalf("expected ErrParseResponse, got %v", err)
+		}
+	})
+
+	t.Run("2xx with a failing stream wraps ErrReadResponse", func(t *testing.T) {
+		httpResp := makeHTTPResponse(200, "")
+		httpResp.Body = io.NopCloser(io.MultiReader(strings.NewReader(`{"key":`), iotest.ErrReader(errors.New("connection reset"))))
+		_, err := ParseResponseBody[map[string]string](httpResp, &captureLogger{})
+		if !errors.Is(err, ErrReadResponse) {
+			t.Fatalf("expected ErrReadResponse, got %v", err)
+		}
+	})
+
 	t.Run("nil httpResp returns error without panic", func(t *testing.T) {
 		logger := &captureLogger{}
 		resp, err := ParseResponseBody[map[string]string](nil, logger)